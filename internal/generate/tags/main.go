@@ -800,6 +800,8 @@ func awsServiceName(s string) (string, error) {
 		return "eventbridge", nil
 	case "lexmodels":
 		return "lexmodelbuildingservice", nil
+	case "rbin":
+		return "recyclebin", nil
 	case "serverlessrepo":
 		return "serverlessapplicationrepository", nil
 	}
@@ -835,6 +837,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["eventbridge"], nil
 	case "lexmodels":
 		return awsServiceNames["lexmodelbuildingservice"], nil
+	case "rbin":
+		return awsServiceNames["recyclebin"], nil
 	case "serverlessrepo":
 		return awsServiceNames["serverlessapplicationrepository"], nil
 	}
@@ -984,6 +988,7 @@ func init() {
 	awsServiceNames["imagebuilder"] = "ImageBuilder"
 	awsServiceNames["imagebuilder"] = "Imagebuilder"
 	awsServiceNames["inspector"] = "Inspector"
+	awsServiceNames["inspector2"] = "Inspector2"
 	awsServiceNames["iot"] = "IoT"
 	awsServiceNames["iot1clickdevices"] = "IoT1ClickDevices"
 	awsServiceNames["iot1clickprojects"] = "IoT1ClickProjects"
@@ -1050,6 +1055,7 @@ func init() {
 	awsServiceNames["networkfirewall"] = "NetworkFirewall"
 	awsServiceNames["networkmanager"] = "NetworkManager"
 	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opensearchservice"] = "OpenSearchService"
 	awsServiceNames["opsworks"] = "OpsWorks"
 	awsServiceNames["opsworkscm"] = "OpsWorksCM"
 	awsServiceNames["organizations"] = "Organizations"
@@ -1069,6 +1075,7 @@ func init() {
 	awsServiceNames["qldbsession"] = "QLDBSession"
 	awsServiceNames["quicksight"] = "QuickSight"
 	awsServiceNames["ram"] = "RAM"
+	awsServiceNames["recyclebin"] = "RecycleBin"
 	awsServiceNames["rds"] = "RDS"
 	awsServiceNames["rdsdata"] = "RDSData"
 	awsServiceNames["rdsutils"] = "RDSUtils"
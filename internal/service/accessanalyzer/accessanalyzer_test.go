@@ -18,6 +18,14 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			"Tags":              testAccAnalyzer_Tags,
 			"Type_Organization": testAccAnalyzer_Type_Organization,
 		},
+		"ArchiveRule": {
+			"basic":         testAccArchiveRule_basic,
+			"disappears":    testAccArchiveRule_disappears,
+			"updateFilters": testAccArchiveRule_updateFilters,
+		},
+		"FindingsDataSource": {
+			"basic": testAccFindingsDataSource_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,212 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     filterCriterionResource(),
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"analyzed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"condition": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"principal": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sort": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"order_by": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      accessanalyzer.OrderByAsc,
+							ValidateFunc: validation.StringInSlice(accessanalyzer.OrderBy_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	input := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: aws.String(analyzerARN),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filter = expandFilterCriteria(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("sort"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.Sort = &accessanalyzer.SortCriteria{
+			AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			OrderBy:       aws.String(tfMap["order_by"].(string)),
+		}
+	}
+
+	findings, err := FindFindings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Findings for Analyzer (%s): %w", analyzerARN, err)
+	}
+
+	var ids []string
+
+	for _, finding := range findings {
+		ids = append(ids, aws.StringValue(finding.Id))
+	}
+
+	d.SetId(analyzerARN)
+	if err := d.Set("findings", flattenFindingSummaries(findings)); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
+
+func flattenFindingSummary(apiObject *accessanalyzer.FindingSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action":                 aws.StringValueSlice(apiObject.Action),
+		"condition":              aws.StringValueMap(apiObject.Condition),
+		"error":                  aws.StringValue(apiObject.Error),
+		"id":                     aws.StringValue(apiObject.Id),
+		"is_public":              aws.BoolValue(apiObject.IsPublic),
+		"principal":              aws.StringValueMap(apiObject.Principal),
+		"resource":               aws.StringValue(apiObject.Resource),
+		"resource_owner_account": aws.StringValue(apiObject.ResourceOwnerAccount),
+		"resource_type":          aws.StringValue(apiObject.ResourceType),
+		"status":                 aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.AnalyzedAt; v != nil {
+		tfMap["analyzed_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CreatedAt; v != nil {
+		tfMap["created_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.UpdatedAt; v != nil {
+		tfMap["updated_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenFindingSummaries(apiObjects []*accessanalyzer.FindingSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenFindingSummary(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,59 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_accessanalyzer_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "analyzer_arn", "aws_accessanalyzer_analyzer.test", "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+data "aws_accessanalyzer_findings" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+
+  filter {
+    criteria = "status"
+    eq       = ["ACTIVE"]
+  }
+
+  filter {
+    criteria = "resourceType"
+    eq       = ["AWS::S3::Bucket", "AWS::IAM::Role"]
+  }
+
+  sort {
+    attribute_name = "updatedAt"
+    order_by       = "DESC"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,195 @@
+package ce
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalyMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAnomalyMonitorCreate,
+		Read:   resourceAnomalyMonitorRead,
+		Update: resourceAnomalyMonitorUpdate,
+		Delete: resourceAnomalyMonitorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_dimension": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(costexplorer.MonitorDimension_Values(), false),
+				ConflictsWith: []string{"monitor_specification"},
+			},
+			"monitor_specification": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ConflictsWith:    []string{"monitor_dimension"},
+			},
+			"monitor_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.MonitorType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAnomalyMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	name := d.Get("name").(string)
+	monitor := &costexplorer.AnomalyMonitor{
+		MonitorName: aws.String(name),
+		MonitorType: aws.String(d.Get("monitor_type").(string)),
+	}
+
+	if v, ok := d.GetOk("monitor_dimension"); ok {
+		monitor.MonitorDimension = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("monitor_specification"); ok {
+		expression, err := expandAnomalyMonitorSpecification(v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		monitor.MonitorSpecification = expression
+	}
+
+	input := &costexplorer.CreateAnomalyMonitorInput{
+		AnomalyMonitor: monitor,
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Monitor: %s", input)
+	output, err := conn.CreateAnomalyMonitor(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cost Explorer Anomaly Monitor (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MonitorArn))
+
+	return resourceAnomalyMonitorRead(d, meta)
+}
+
+func resourceAnomalyMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	monitor, err := FindAnomalyMonitorByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Monitor %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", monitor.MonitorArn)
+	d.Set("monitor_dimension", monitor.MonitorDimension)
+	if monitor.MonitorSpecification != nil {
+		specification, err := flattenAnomalyMonitorSpecification(monitor.MonitorSpecification)
+
+		if err != nil {
+			return err
+		}
+
+		d.Set("monitor_specification", specification)
+	} else {
+		d.Set("monitor_specification", nil)
+	}
+	d.Set("monitor_type", monitor.MonitorType)
+	d.Set("name", monitor.MonitorName)
+
+	return nil
+}
+
+func resourceAnomalyMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	if d.HasChange("name") {
+		input := &costexplorer.UpdateAnomalyMonitorInput{
+			MonitorArn:  aws.String(d.Id()),
+			MonitorName: aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Cost Explorer Anomaly Monitor: %s", input)
+		_, err := conn.UpdateAnomalyMonitor(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyMonitorRead(d, meta)
+}
+
+func resourceAnomalyMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Monitor: %s", d.Id())
+	_, err := conn.DeleteAnomalyMonitor(&costexplorer.DeleteAnomalyMonitorInput{
+		MonitorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cost Explorer Anomaly Monitor (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAnomalyMonitorSpecification(v string) (*costexplorer.Expression, error) {
+	var expression costexplorer.Expression
+
+	if err := json.Unmarshal([]byte(v), &expression); err != nil {
+		return nil, fmt.Errorf("error decoding monitor_specification: %w", err)
+	}
+
+	return &expression, nil
+}
+
+func flattenAnomalyMonitorSpecification(apiObject *costexplorer.Expression) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("error encoding monitor_specification: %w", err)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,245 @@
+package ce
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalySubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAnomalySubscriptionCreate,
+		Read:   resourceAnomalySubscriptionRead,
+		Update: resourceAnomalySubscriptionUpdate,
+		Delete: resourceAnomalySubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.AnomalySubscriptionFrequency_Values(), false),
+			},
+			"monitor_arn_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(6, 302),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.SubscriberType_Values(), false),
+						},
+					},
+				},
+			},
+			"threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceAnomalySubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	name := d.Get("name").(string)
+	subscription := &costexplorer.AnomalySubscription{
+		Frequency:        aws.String(d.Get("frequency").(string)),
+		MonitorArnList:   flex.ExpandStringSet(d.Get("monitor_arn_list").(*schema.Set)),
+		Subscribers:      expandSubscribers(d.Get("subscriber").(*schema.Set).List()),
+		SubscriptionName: aws.String(name),
+		Threshold:        aws.Float64(d.Get("threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("account_id"); ok {
+		subscription.AccountId = aws.String(v.(string))
+	}
+
+	input := &costexplorer.CreateAnomalySubscriptionInput{
+		AnomalySubscription: subscription,
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Subscription: %s", input)
+	output, err := conn.CreateAnomalySubscription(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cost Explorer Anomaly Subscription (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SubscriptionArn))
+
+	return resourceAnomalySubscriptionRead(d, meta)
+}
+
+func resourceAnomalySubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	subscription, err := FindAnomalySubscriptionByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Subscription %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cost Explorer Anomaly Subscription (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", subscription.AccountId)
+	d.Set("arn", subscription.SubscriptionArn)
+	d.Set("frequency", subscription.Frequency)
+	d.Set("monitor_arn_list", aws.StringValueSlice(subscription.MonitorArnList))
+	d.Set("name", subscription.SubscriptionName)
+	if err := d.Set("subscriber", flattenSubscribers(subscription.Subscribers)); err != nil {
+		return fmt.Errorf("error setting subscriber: %w", err)
+	}
+	d.Set("threshold", subscription.Threshold)
+
+	return nil
+}
+
+func resourceAnomalySubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	input := &costexplorer.UpdateAnomalySubscriptionInput{
+		SubscriptionArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("frequency") {
+		input.Frequency = aws.String(d.Get("frequency").(string))
+	}
+
+	if d.HasChange("monitor_arn_list") {
+		input.MonitorArnList = flex.ExpandStringSet(d.Get("monitor_arn_list").(*schema.Set))
+	}
+
+	if d.HasChange("name") {
+		input.SubscriptionName = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("subscriber") {
+		input.Subscribers = expandSubscribers(d.Get("subscriber").(*schema.Set).List())
+	}
+
+	if d.HasChange("threshold") {
+		input.Threshold = aws.Float64(d.Get("threshold").(float64))
+	}
+
+	log.Printf("[DEBUG] Updating Cost Explorer Anomaly Subscription: %s", input)
+	_, err := conn.UpdateAnomalySubscription(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Cost Explorer Anomaly Subscription (%s): %w", d.Id(), err)
+	}
+
+	return resourceAnomalySubscriptionRead(d, meta)
+}
+
+func resourceAnomalySubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Subscription: %s", d.Id())
+	_, err := conn.DeleteAnomalySubscription(&costexplorer.DeleteAnomalySubscriptionInput{
+		SubscriptionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownSubscriptionException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cost Explorer Anomaly Subscription (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSubscribers(tfList []interface{}) []*costexplorer.Subscriber {
+	var apiObjects []*costexplorer.Subscriber
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.Subscriber{}
+
+		if v, ok := tfMap["address"].(string); ok && v != "" {
+			apiObject.Address = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSubscribers(apiObjects []*costexplorer.Subscriber) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"address": aws.StringValue(apiObject.Address),
+			"type":    aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
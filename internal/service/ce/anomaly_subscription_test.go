@@ -0,0 +1,183 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCEAnomalySubscription_basic(t *testing.T) {
+	var v costexplorer.AnomalySubscription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	monitorResourceName := "aws_ce_anomaly_monitor.test"
+	address := acctest.DefaultEmailAddress
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalysubscription/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "frequency", costexplorer.AnomalySubscriptionFrequencyDaily),
+					resource.TestCheckResourceAttr(resourceName, "monitor_arn_list.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor_arn_list.*", monitorResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address,
+						"type":    costexplorer.SubscriberTypeEmail,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_disappears(t *testing.T) {
+	var v costexplorer.AnomalySubscription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, acctest.DefaultEmailAddress, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceAnomalySubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_update(t *testing.T) {
+	var v costexplorer.AnomalySubscription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address1 := acctest.RandomEmailAddress(domain)
+	address2 := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address1, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address1,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address2, 250.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address2,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "250.5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalySubscriptionExists(n string, v *costexplorer.AnomalySubscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Subscription ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		output, err := tfce.FindAnomalySubscriptionByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalySubscriptionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_subscription" {
+			continue
+		}
+
+		_, err := tfce.FindAnomalySubscriptionByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Subscription %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnomalySubscriptionConfig(rName, address string, threshold float64) string {
+	return acctest.ConfigCompose(testAccAnomalyMonitorConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "DAILY"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+  threshold        = %[3]g
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+}
+`, rName, address, threshold))
+}
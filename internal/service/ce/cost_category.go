@@ -0,0 +1,649 @@
+package ce
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCostCategory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCostCategoryCreate,
+		Read:   resourceCostCategoryRead,
+		Update: resourceCostCategoryUpdate,
+		Delete: resourceCostCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"effective_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherited_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dimension_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryInheritedValueDimensionName_Values(), false),
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     expressionElem(expressionMaxDepth),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"rule_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      costexplorer.CostCategoryRuleVersionCostCategoryExpressionV1,
+				ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleVersion_Values(), false),
+			},
+			"split_charge_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeMethod_Values(), false),
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeRuleParameterType_Values(), false),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expressionMaxDepth is the number of levels of nested and/or/not expressions supported.
+const expressionMaxDepth = 3
+
+func expressionElem(level int) *schema.Resource {
+	elem := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cost_category": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     expressionValuesElem(nil),
+			},
+			"dimension": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     expressionValuesElem(validation.StringInSlice(costexplorer.Dimension_Values(), false)),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     expressionValuesElem(nil),
+			},
+		},
+	}
+
+	if level > 1 {
+		elem.Schema["and"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     expressionElem(level - 1),
+		}
+		elem.Schema["not"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     expressionElem(level - 1),
+		}
+		elem.Schema["or"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     expressionElem(level - 1),
+		}
+	}
+
+	return elem
+}
+
+func expressionValuesElem(keyValidateFunc schema.SchemaValidateFunc) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: keyValidateFunc,
+			},
+			"match_options": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+				},
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCostCategoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	name := d.Get("name").(string)
+	input := &costexplorer.CreateCostCategoryDefinitionInput{
+		Name:        aws.String(name),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+		Rules:       expandCostCategoryRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Cost Category: %s", input)
+	output, err := conn.CreateCostCategoryDefinition(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cost Explorer Cost Category (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CostCategoryArn))
+
+	return resourceCostCategoryRead(d, meta)
+}
+
+func resourceCostCategoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	costCategory, err := FindCostCategoryByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Cost Category %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cost Explorer Cost Category (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", costCategory.CostCategoryArn)
+	d.Set("default_value", costCategory.DefaultValue)
+	d.Set("effective_end", costCategory.EffectiveEnd)
+	d.Set("effective_start", costCategory.EffectiveStart)
+	d.Set("name", costCategory.Name)
+	if err := d.Set("rule", flattenCostCategoryRules(costCategory.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+	d.Set("rule_version", costCategory.RuleVersion)
+	if err := d.Set("split_charge_rule", flattenCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+		return fmt.Errorf("error setting split_charge_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCostCategoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	input := &costexplorer.UpdateCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+		RuleVersion:     aws.String(d.Get("rule_version").(string)),
+		Rules:           expandCostCategoryRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Updating Cost Explorer Cost Category: %s", input)
+	_, err := conn.UpdateCostCategoryDefinition(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Cost Explorer Cost Category (%s): %w", d.Id(), err)
+	}
+
+	return resourceCostCategoryRead(d, meta)
+}
+
+func resourceCostCategoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Cost Category: %s", d.Id())
+	_, err := conn.DeleteCostCategoryDefinition(&costexplorer.DeleteCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cost Explorer Cost Category (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCostCategoryRules(tfList []interface{}) []*costexplorer.CostCategoryRule {
+	var apiObjects []*costexplorer.CostCategoryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategoryRule{}
+
+		if v, ok := tfMap["inherited_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.InheritedValue = expandCostCategoryInheritedValueDimension(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Rule = expandExpression(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCostCategoryInheritedValueDimension(tfMap map[string]interface{}) *costexplorer.CostCategoryInheritedValueDimension {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryInheritedValueDimension{}
+
+	if v, ok := tfMap["dimension_key"].(string); ok && v != "" {
+		apiObject.DimensionKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_name"].(string); ok && v != "" {
+		apiObject.DimensionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCostCategorySplitChargeRules(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRule {
+	var apiObjects []*costexplorer.CostCategorySplitChargeRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategorySplitChargeRule{}
+
+		if v, ok := tfMap["method"].(string); ok && v != "" {
+			apiObject.Method = aws.String(v)
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandCostCategorySplitChargeRuleParameters(v.List())
+		}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.Source = aws.String(v)
+		}
+
+		if v, ok := tfMap["targets"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Targets = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCostCategorySplitChargeRuleParameters(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRuleParameter {
+	var apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategorySplitChargeRuleParameter{}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Values = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandExpression(tfMap map[string]interface{}) *costexplorer.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.Expression{}
+
+	if v, ok := tfMap["and"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.And = expandExpressions(v.List())
+	}
+
+	if v, ok := tfMap["cost_category"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		key, matchOptions, values := expandExpressionValues(v[0].(map[string]interface{}))
+		apiObject.CostCategories = &costexplorer.CostCategoryValues{
+			Key:          key,
+			MatchOptions: matchOptions,
+			Values:       values,
+		}
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		key, matchOptions, values := expandExpressionValues(v[0].(map[string]interface{}))
+		apiObject.Dimensions = &costexplorer.DimensionValues{
+			Key:          key,
+			MatchOptions: matchOptions,
+			Values:       values,
+		}
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Or = expandExpressions(v.List())
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		key, matchOptions, values := expandExpressionValues(v[0].(map[string]interface{}))
+		apiObject.Tags = &costexplorer.TagValues{
+			Key:          key,
+			MatchOptions: matchOptions,
+			Values:       values,
+		}
+	}
+
+	return apiObject
+}
+
+func expandExpressions(tfList []interface{}) []*costexplorer.Expression {
+	var apiObjects []*costexplorer.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandExpression(tfMap))
+	}
+
+	return apiObjects
+}
+
+// expandExpressionValues returns the key, match options and values shared by the cost category, dimension and tag expressions.
+func expandExpressionValues(tfMap map[string]interface{}) (*string, []*string, []*string) {
+	var key *string
+	var matchOptions, values []*string
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		matchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		values = flex.ExpandStringSet(v)
+	}
+
+	return key, matchOptions, values
+}
+
+func flattenCostCategoryRules(apiObjects []*costexplorer.CostCategoryRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		}
+
+		if v := apiObject.InheritedValue; v != nil {
+			tfMap["inherited_value"] = []interface{}{map[string]interface{}{
+				"dimension_key":  aws.StringValue(v.DimensionKey),
+				"dimension_name": aws.StringValue(v.DimensionName),
+			}}
+		}
+
+		if v := apiObject.Rule; v != nil {
+			tfMap["rule"] = []interface{}{flattenExpression(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenCostCategorySplitChargeRules(apiObjects []*costexplorer.CostCategorySplitChargeRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"method":  aws.StringValue(apiObject.Method),
+			"source":  aws.StringValue(apiObject.Source),
+			"targets": aws.StringValueSlice(apiObject.Targets),
+		}
+
+		if v := apiObject.Parameters; v != nil {
+			var tfParameters []interface{}
+
+			for _, parameter := range v {
+				if parameter == nil {
+					continue
+				}
+
+				tfParameters = append(tfParameters, map[string]interface{}{
+					"type":   aws.StringValue(parameter.Type),
+					"values": aws.StringValueSlice(parameter.Values),
+				})
+			}
+
+			tfMap["parameter"] = tfParameters
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenExpression(apiObject *costexplorer.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = flattenExpressions(v)
+	}
+
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_category"] = []interface{}{flattenExpressionValues(v.Key, v.MatchOptions, v.Values)}
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimension"] = []interface{}{flattenExpressionValues(v.Key, v.MatchOptions, v.Values)}
+	}
+
+	if v := apiObject.Not; v != nil {
+		tfMap["not"] = []interface{}{flattenExpression(v)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = flattenExpressions(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = []interface{}{flattenExpressionValues(v.Key, v.MatchOptions, v.Values)}
+	}
+
+	return tfMap
+}
+
+func flattenExpressions(apiObjects []*costexplorer.Expression) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenExpression(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenExpressionValues(key *string, matchOptions, values []*string) map[string]interface{} {
+	return map[string]interface{}{
+		"key":           aws.StringValue(key),
+		"match_options": aws.StringValueSlice(matchOptions),
+		"values":        aws.StringValueSlice(values),
+	}
+}
@@ -0,0 +1,207 @@
+package ce
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceCostCategory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCostCategoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"cost_category_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"default_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherited_value": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dimension_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     dataSourceExpressionElem(expressionMaxDepth),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rule_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"split_charge_rule": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExpressionElem(level int) *schema.Resource {
+	elem := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cost_category": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceExpressionValuesElem(),
+			},
+			"dimension": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceExpressionValuesElem(),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceExpressionValuesElem(),
+			},
+		},
+	}
+
+	if level > 1 {
+		elem.Schema["and"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     dataSourceExpressionElem(level - 1),
+		}
+		elem.Schema["not"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     dataSourceExpressionElem(level - 1),
+		}
+		elem.Schema["or"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     dataSourceExpressionElem(level - 1),
+		}
+	}
+
+	return elem
+}
+
+func dataSourceExpressionValuesElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"match_options": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCostCategoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	arn := d.Get("cost_category_arn").(string)
+	costCategory, err := FindCostCategoryByARN(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Cost Explorer Cost Category (%s): %w", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("default_value", costCategory.DefaultValue)
+	d.Set("effective_end", costCategory.EffectiveEnd)
+	d.Set("effective_start", costCategory.EffectiveStart)
+	d.Set("name", costCategory.Name)
+	if err := d.Set("rule", flattenCostCategoryRules(costCategory.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+	d.Set("rule_version", costCategory.RuleVersion)
+	if err := d.Set("split_charge_rule", flattenCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+		return fmt.Errorf("error setting split_charge_rule: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package ce_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCECostCategoryDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+	dataSourceName := "data.aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cost_category_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_value", resourceName, "default_value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "effective_start", resourceName, "effective_start"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.#", resourceName, "rule.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.value", resourceName, "rule.0.value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.rule.0.dimension.0.key", resourceName, "rule.0.rule.0.dimension.0.key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule_version", resourceName, "rule_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "split_charge_rule.#", resourceName, "split_charge_rule.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCostCategoryDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccCostCategoryConfig(rName), `
+data "aws_ce_cost_category" "test" {
+  cost_category_arn = aws_ce_cost_category.test.arn
+}
+`)
+}
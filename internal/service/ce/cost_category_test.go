@@ -0,0 +1,268 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCECostCategory_basic(t *testing.T) {
+	var v costexplorer.CostCategory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`costcategory/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_value", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "effective_start"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.type", costexplorer.CostCategoryRuleTypeRegular),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.value", "production"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.key", costexplorer.DimensionLinkedAccountName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.match_options.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.rule.0.dimension.0.match_options.*", costexplorer.MatchOptionEndsWith),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.rule.0.dimension.0.values.*", "-prod"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", costexplorer.CostCategoryRuleVersionCostCategoryExpressionV1),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCECostCategory_disappears(t *testing.T) {
+	var v costexplorer.CostCategory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceCostCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCECostCategory_complete(t *testing.T) {
+	var v costexplorer.CostCategory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfigComplete(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "other"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.or.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule.0.and.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.type", costexplorer.CostCategoryRuleTypeInheritedValue),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.inherited_value.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.inherited_value.0.dimension_key", "CostCenter"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.inherited_value.0.dimension_name", costexplorer.CostCategoryInheritedValueDimensionNameTag),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*", map[string]string{
+						"method":    costexplorer.CostCategorySplitChargeMethodProportional,
+						"source":    "shared",
+						"targets.#": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_value", ""),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCostCategoryExists(n string, v *costexplorer.CostCategory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Cost Category ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		output, err := tfce.FindCostCategoryByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCostCategoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_cost_category" {
+			continue
+		}
+
+		_, err := tfce.FindCostCategoryByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Cost Category %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCostCategoryConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name = %[1]q
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        match_options = ["ENDS_WITH"]
+        values        = ["-prod"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCostCategoryConfigComplete(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  default_value = "other"
+
+  rule {
+    value = "production"
+
+    rule {
+      or {
+        dimension {
+          key           = "LINKED_ACCOUNT_NAME"
+          match_options = ["ENDS_WITH"]
+          values        = ["-prod"]
+        }
+      }
+
+      or {
+        tags {
+          key           = "Environment"
+          match_options = ["EQUALS"]
+          values        = ["production"]
+        }
+      }
+    }
+  }
+
+  rule {
+    value = "shared"
+
+    rule {
+      and {
+        dimension {
+          key           = "SERVICE_CODE"
+          match_options = ["EQUALS"]
+          values        = ["AmazonVPC", "AWSCloudTrail"]
+        }
+      }
+
+      and {
+        not {
+          tags {
+            key           = "Environment"
+            match_options = ["ABSENT"]
+          }
+        }
+      }
+    }
+  }
+
+  rule {
+    type = "INHERITED_VALUE"
+
+    inherited_value {
+      dimension_key  = "CostCenter"
+      dimension_name = "TAG"
+    }
+  }
+
+  split_charge_rule {
+    method  = "PROPORTIONAL"
+    source  = "shared"
+    targets = ["production", "other"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,86 @@
+package ce
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAnomalyMonitorByARN(conn *costexplorer.CostExplorer, arn string) (*costexplorer.AnomalyMonitor, error) {
+	input := &costexplorer.GetAnomalyMonitorsInput{
+		MaxResults:     aws.Int64(1),
+		MonitorArnList: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetAnomalyMonitors(input)
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AnomalyMonitors) == 0 || output.AnomalyMonitors[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnomalyMonitors[0], nil
+}
+
+func FindAnomalySubscriptionByARN(conn *costexplorer.CostExplorer, arn string) (*costexplorer.AnomalySubscription, error) {
+	input := &costexplorer.GetAnomalySubscriptionsInput{
+		MaxResults:          aws.Int64(1),
+		SubscriptionArnList: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetAnomalySubscriptions(input)
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownSubscriptionException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AnomalySubscriptions) == 0 || output.AnomalySubscriptions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnomalySubscriptions[0], nil
+}
+
+func FindCostCategoryByARN(conn *costexplorer.CostExplorer, arn string) (*costexplorer.CostCategory, error) {
+	input := &costexplorer.DescribeCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCostCategoryDefinition(input)
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CostCategory == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CostCategory, nil
+}
@@ -0,0 +1,173 @@
+package ce
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     expressionElem(expressionMaxDepth),
+			},
+			"search_string": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 1024),
+				ConflictsWith: []string{"sort_by"},
+			},
+			"sort_by": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sort_order": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.SortOrder_Values(), false),
+						},
+					},
+				},
+				ConflictsWith: []string{"search_string"},
+			},
+			"tag_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"time_period": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDate,
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDate,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var validDate = validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format")
+
+func dataSourceTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	input := &costexplorer.GetTagsInput{
+		TimePeriod: expandDateInterval(d.Get("time_period").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Filter = expandExpression(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("search_string"); ok {
+		input.SearchString = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sort_by"); ok && len(v.([]interface{})) > 0 {
+		input.SortBy = expandSortDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_key"); ok {
+		input.TagKey = aws.String(v.(string))
+	}
+
+	var tags []*string
+
+	for {
+		output, err := conn.GetTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading Cost Explorer Tags: %w", err)
+		}
+
+		tags = append(tags, output.Tags...)
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = output.NextPageToken
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("tags", aws.StringValueSlice(tags))
+
+	return nil
+}
+
+func expandDateInterval(tfMap map[string]interface{}) *costexplorer.DateInterval {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.DateInterval{}
+
+	if v, ok := tfMap["end"].(string); ok && v != "" {
+		apiObject.End = aws.String(v)
+	}
+
+	if v, ok := tfMap["start"].(string); ok && v != "" {
+		apiObject.Start = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSortDefinitions(tfList []interface{}) []*costexplorer.SortDefinition {
+	var apiObjects []*costexplorer.SortDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.SortDefinition{}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["sort_order"].(string); ok && v != "" {
+			apiObject.SortOrder = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
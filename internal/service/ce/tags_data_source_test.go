@@ -0,0 +1,88 @@
+package ce_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCETagsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ce_tags.test"
+	end := time.Now().UTC().Format("2006-01-02")
+	start := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01-02")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsDataSourceConfig(start, end),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCETagsDataSource_filter(t *testing.T) {
+	dataSourceName := "data.aws_ce_tags.test"
+	end := time.Now().UTC().Format("2006-01-02")
+	start := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01-02")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsDataSourceConfigFilter(start, end),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagsDataSourceConfig(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_tags" "test" {
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+}
+`, start, end)
+}
+
+func testAccTagsDataSourceConfigFilter(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_ce_tags" "test" {
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+
+  filter {
+    dimension {
+      key    = "REGION"
+      values = [data.aws_region.current.name]
+    }
+  }
+
+  sort_by {
+    key        = "BlendedCost"
+    sort_order = "DESCENDING"
+  }
+}
+`, start, end)
+}
@@ -0,0 +1,127 @@
+package ec2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeAccountAttributes.html#API_DescribeAccountAttributes_RequestParameters.
+const (
+	accountAttributeNameMaxElasticIPs                    = "max-elastic-ips"
+	accountAttributeNameMaxInstances                     = "max-instances"
+	accountAttributeNameVPCMaxElasticIPs                 = "vpc-max-elastic-ips"
+	accountAttributeNameVPCMaxSecurityGroupsPerInterface = "vpc-max-security-groups-per-interface"
+)
+
+func DataSourceAccountAttributes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccountAttributesRead,
+
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_elastic_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_instances": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"supported_platforms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_max_elastic_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vpc_max_security_groups_per_interface": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAccountAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	output, err := FindAccountAttributes(conn, &ec2.DescribeAccountAttributesInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Account Attributes: %w", err)
+	}
+
+	attributes := make(map[string]string)
+	values := make(map[string][]string)
+
+	for _, attribute := range output {
+		name := aws.StringValue(attribute.AttributeName)
+
+		for _, v := range attribute.AttributeValues {
+			if v == nil {
+				continue
+			}
+
+			values[name] = append(values[name], aws.StringValue(v.AttributeValue))
+		}
+
+		attributes[name] = strings.Join(values[name], ",")
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("attributes", attributes); err != nil {
+		return fmt.Errorf("error setting attributes: %w", err)
+	}
+
+	// The value "none" is returned when there is no default VPC.
+	if v := attributes[ec2.AccountAttributeNameDefaultVpc]; v != "none" {
+		d.Set("default_vpc_id", v)
+	} else {
+		d.Set("default_vpc_id", nil)
+	}
+
+	for name, key := range map[string]string{
+		accountAttributeNameMaxElasticIPs:                    "max_elastic_ips",
+		accountAttributeNameMaxInstances:                     "max_instances",
+		accountAttributeNameVPCMaxElasticIPs:                 "vpc_max_elastic_ips",
+		accountAttributeNameVPCMaxSecurityGroupsPerInterface: "vpc_max_security_groups_per_interface",
+	} {
+		v, ok := attributes[name]
+
+		if !ok {
+			d.Set(key, nil)
+			continue
+		}
+
+		n, err := strconv.Atoi(v)
+
+		if err != nil {
+			return fmt.Errorf("error parsing EC2 Account Attribute (%s) value (%s): %w", name, v, err)
+		}
+
+		d.Set(key, n)
+	}
+
+	if err := d.Set("supported_platforms", values[ec2.AccountAttributeNameSupportedPlatforms]); err != nil {
+		return fmt.Errorf("error setting supported_platforms: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package ec2_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2AccountAttributesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_account_attributes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAttributesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "attributes.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "attributes.supported-platforms"),
+					resource.TestMatchResourceAttr(dataSourceName, "max_instances", regexp.MustCompile(`^\d+$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "max_elastic_ips", regexp.MustCompile(`^\d+$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "vpc_max_elastic_ips", regexp.MustCompile(`^\d+$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "vpc_max_security_groups_per_interface", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "supported_platforms.#"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "supported_platforms.*", "VPC"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountAttributesDataSourceConfig = `
+data "aws_ec2_account_attributes" "test" {}
+`
@@ -11,6 +11,28 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+func FindAccountAttributes(conn *ec2.EC2, input *ec2.DescribeAccountAttributesInput) ([]*ec2.AccountAttribute, error) {
+	output, err := conn.DescribeAccountAttributes(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var attributes []*ec2.AccountAttribute
+
+	for _, v := range output.AccountAttributes {
+		if v != nil {
+			attributes = append(attributes, v)
+		}
+	}
+
+	return attributes, nil
+}
+
 // FindCarrierGatewayByID returns the carrier gateway corresponding to the specified identifier.
 // Returns nil and potentially an error if no carrier gateway is found.
 func FindCarrierGatewayByID(conn *ec2.EC2, id string) (*ec2.CarrierGateway, error) {
@@ -1267,6 +1289,70 @@ func FindSecurityGroups(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (
 	return output, nil
 }
 
+func FindSecurityGroupReferences(conn *ec2.EC2, input *ec2.DescribeSecurityGroupReferencesInput) ([]*ec2.SecurityGroupReference, error) {
+	output, err := conn.DescribeSecurityGroupReferences(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound, ErrCodeInvalidSecurityGroupIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	var references []*ec2.SecurityGroupReference
+
+	for _, v := range output.SecurityGroupReferenceSet {
+		if v == nil {
+			continue
+		}
+
+		references = append(references, v)
+	}
+
+	return references, nil
+}
+
+func FindStaleSecurityGroups(conn *ec2.EC2, input *ec2.DescribeStaleSecurityGroupsInput) ([]*ec2.StaleSecurityGroup, error) {
+	var output []*ec2.StaleSecurityGroup
+
+	err := conn.DescribeStaleSecurityGroupsPages(input, func(page *ec2.DescribeStaleSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StaleSecurityGroupSet {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidVpcIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // FindSpotInstanceRequestByID looks up a SpotInstanceRequest by ID. When not found, returns nil and potentially an API error.
 func FindSpotInstanceRequestByID(conn *ec2.EC2, id string) (*ec2.SpotInstanceRequest, error) {
 	input := &ec2.DescribeSpotInstanceRequestsInput{
@@ -1877,6 +1963,25 @@ func FindVPCEndpointRouteTableAssociationExists(conn *ec2.EC2, vpcEndpointID str
 	}
 }
 
+// FindVPCEndpointSecurityGroupAssociationExists returns NotFoundError if no association for the specified VPC endpoint and security group IDs is found.
+func FindVPCEndpointSecurityGroupAssociationExists(conn *ec2.EC2, vpcEndpointID, securityGroupID string) error {
+	vpcEndpoint, err := FindVPCEndpointByID(conn, vpcEndpointID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, vpcEndpointSecurityGroup := range vpcEndpoint.Groups {
+		if aws.StringValue(vpcEndpointSecurityGroup.GroupId) == securityGroupID {
+			return nil
+		}
+	}
+
+	return &resource.NotFoundError{
+		LastError: fmt.Errorf("VPC Endpoint (%s) Security Group (%s) Association not found", vpcEndpointID, securityGroupID),
+	}
+}
+
 // FindVPCEndpointSubnetAssociationExists returns NotFoundError if no association for the specified VPC endpoint and subnet IDs is found.
 func FindVPCEndpointSubnetAssociationExists(conn *ec2.EC2, vpcEndpointID string, subnetID string) error {
 	vpcEndpoint, err := FindVPCEndpointByID(conn, vpcEndpointID)
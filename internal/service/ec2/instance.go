@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -439,6 +440,32 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"private_dns_name_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_resource_name_dns_aaaa_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"enable_resource_name_dns_a_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"hostname_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ec2.HostnameType_Values(), false),
+						},
+					},
+				},
+			},
 			"private_ip": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -759,6 +786,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		HibernationOptions:                instanceOpts.HibernationOptions,
 		MetadataOptions:                   instanceOpts.MetadataOptions,
 		EnclaveOptions:                    instanceOpts.EnclaveOptions,
+		PrivateDnsNameOptions:             instanceOpts.PrivateDNSNameOptions,
 		TagSpecifications:                 tagSpecifications,
 	}
 
@@ -957,6 +985,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting enclave_options: %s", err)
 	}
 
+	if err := d.Set("private_dns_name_options", flattenEc2PrivateDNSNameOptionsResponse(instance.PrivateDnsNameOptions)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_options: %w", err)
+	}
+
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
 	d.Set("key_name", instance.KeyName)
@@ -1476,20 +1508,8 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err)
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
-			Target:     []string{ec2.InstanceStateNameRunning},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{ec2.InstanceStateNameTerminated}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+		if err := WaitForInstanceStarting(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
@@ -1588,6 +1608,76 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("private_dns_name_options") && !d.IsNewResource() {
+		if v, ok := d.GetOk("private_dns_name_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			input := &ec2.ModifyPrivateDnsNameOptionsInput{
+				InstanceId: aws.String(d.Id()),
+			}
+
+			if d.HasChange("private_dns_name_options.0.enable_resource_name_dns_aaaa_record") {
+				input.EnableResourceNameDnsAAAARecord = aws.Bool(tfMap["enable_resource_name_dns_aaaa_record"].(bool))
+			}
+
+			if d.HasChange("private_dns_name_options.0.enable_resource_name_dns_a_record") {
+				input.EnableResourceNameDnsARecord = aws.Bool(tfMap["enable_resource_name_dns_a_record"].(bool))
+			}
+
+			// The hostname type can only be changed while the instance is stopped.
+			restart := false
+
+			if d.HasChange("private_dns_name_options.0.hostname_type") {
+				input.PrivateDnsHostnameType = aws.String(tfMap["hostname_type"].(string))
+
+				instance, err := InstanceFindByID(conn, d.Id())
+
+				if err != nil {
+					return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
+				}
+
+				if instance != nil && instance.State != nil && aws.StringValue(instance.State.Name) == ec2.InstanceStateNameRunning {
+					restart = true
+
+					log.Printf("[INFO] Stopping Instance %q for hostname_type change", d.Id())
+					_, err := conn.StopInstances(&ec2.StopInstancesInput{
+						InstanceIds: []*string{aws.String(d.Id())},
+					})
+
+					if err != nil {
+						return fmt.Errorf("error stopping EC2 Instance (%s): %w", d.Id(), err)
+					}
+
+					if err := WaitForInstanceStopping(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return err
+					}
+				}
+			}
+
+			log.Printf("[DEBUG] Modifying EC2 Instance private DNS name options: %s", input)
+			_, err := conn.ModifyPrivateDnsNameOptions(input)
+
+			if err != nil {
+				return fmt.Errorf("error modifying EC2 Instance (%s) private DNS name options: %w", d.Id(), err)
+			}
+
+			if restart {
+				log.Printf("[INFO] Starting Instance %q after hostname_type change", d.Id())
+				_, err := conn.StartInstances(&ec2.StartInstancesInput{
+					InstanceIds: []*string{aws.String(d.Id())},
+				})
+
+				if err != nil {
+					return fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err)
+				}
+
+				if err := WaitForInstanceStarting(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	if d.HasChange("root_block_device.0") && !d.IsNewResource() {
 		volumeID := d.Get("root_block_device.0.volume_id").(string)
 
@@ -2575,6 +2665,7 @@ type awsInstanceOpts struct {
 	HibernationOptions                *ec2.HibernationOptionsRequest
 	MetadataOptions                   *ec2.InstanceMetadataOptionsRequest
 	EnclaveOptions                    *ec2.EnclaveOptionsRequest
+	PrivateDNSNameOptions             *ec2.PrivateDnsNameOptionsRequest
 }
 
 func buildInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanceOpts, error) {
@@ -2587,6 +2678,9 @@ func buildInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanceOp
 		EnclaveOptions:        expandEc2EnclaveOptions(d.Get("enclave_options").([]interface{})),
 	}
 
+	// Only send the options that are set in configuration so that subnet defaults apply to the rest.
+	opts.PrivateDNSNameOptions = expandEc2PrivateDNSNameOptionsRequest(d.GetRawConfig().GetAttr("private_dns_name_options"))
+
 	if v, ok := d.GetOk("ami"); ok {
 		opts.ImageID = aws.String(v.(string))
 	}
@@ -2803,6 +2897,27 @@ func WaitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) er
 	return nil
 }
 
+func WaitForInstanceStarting(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for instance (%s) to become running", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
+		Target:     []string{ec2.InstanceStateNameRunning},
+		Refresh:    InstanceStateRefreshFunc(conn, id, []string{ec2.InstanceStateNameTerminated}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"error waiting for instance (%s) to start: %s", id, err)
+	}
+
+	return nil
+}
+
 func waitForInstanceDeletion(conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become terminated", id)
 
@@ -2971,6 +3086,34 @@ func expandEc2EnclaveOptions(l []interface{}) *ec2.EnclaveOptionsRequest {
 	return opts
 }
 
+func expandEc2PrivateDNSNameOptionsRequest(tfList cty.Value) *ec2.PrivateDnsNameOptionsRequest {
+	if !tfList.IsKnown() || tfList.IsNull() || tfList.LengthInt() == 0 {
+		return nil
+	}
+
+	tfMap := tfList.Index(cty.NumberIntVal(0))
+
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	apiObject := &ec2.PrivateDnsNameOptionsRequest{}
+
+	if v := tfMap.GetAttr("enable_resource_name_dns_aaaa_record"); v.IsKnown() && !v.IsNull() {
+		apiObject.EnableResourceNameDnsAAAARecord = aws.Bool(v.True())
+	}
+
+	if v := tfMap.GetAttr("enable_resource_name_dns_a_record"); v.IsKnown() && !v.IsNull() {
+		apiObject.EnableResourceNameDnsARecord = aws.Bool(v.True())
+	}
+
+	if v := tfMap.GetAttr("hostname_type"); v.IsKnown() && !v.IsNull() && v.AsString() != "" {
+		apiObject.HostnameType = aws.String(v.AsString())
+	}
+
+	return apiObject
+}
+
 //Expands an array of secondary Private IPs into a ec2 Private IP Address Spec
 func expandSecondaryPrivateIPAddresses(ips []interface{}) []*ec2.PrivateIpAddressSpecification {
 	specs := make([]*ec2.PrivateIpAddressSpecification, 0, len(ips))
@@ -3047,6 +3190,20 @@ func flattenEc2EnclaveOptions(opts *ec2.EnclaveOptions) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenEc2PrivateDNSNameOptionsResponse(apiObject *ec2.PrivateDnsNameOptionsResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_resource_name_dns_aaaa_record": aws.BoolValue(apiObject.EnableResourceNameDnsAAAARecord),
+		"enable_resource_name_dns_a_record":    aws.BoolValue(apiObject.EnableResourceNameDnsARecord),
+		"hostname_type":                        aws.StringValue(apiObject.HostnameType),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCapacityReservationSpecification(crs *ec2.CapacityReservationSpecificationResponse) []interface{} {
 	if crs == nil {
 		return []interface{}{}
@@ -358,6 +358,26 @@ func DataSourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"private_dns_name_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_resource_name_dns_aaaa_record": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_resource_name_dns_a_record": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hostname_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -603,5 +623,9 @@ func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instanc
 		return fmt.Errorf("error setting enclave_options: %w", err)
 	}
 
+	if err := d.Set("private_dns_name_options", flattenEc2PrivateDNSNameOptionsResponse(instance.PrivateDnsNameOptions)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_options: %w", err)
+	}
+
 	return nil
 }
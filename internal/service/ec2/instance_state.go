@@ -0,0 +1,172 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceStateCreate,
+		Read:   resourceInstanceStateRead,
+		Update: resourceInstanceStateUpdate,
+		Delete: resourceInstanceStateDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("force", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(InstanceStopTimeout),
+			Update: schema.DefaultTimeout(InstanceStopTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameStopped,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("instance_id").(string)
+
+	instance, err := FindInstanceByID(conn, instanceID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	if err := updateInstanceState(conn, instanceID, aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance State (%s): %w", d.Id(), err)
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("state", instance.State.Name)
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("state") {
+		o, n := d.GetChange("state")
+
+		if err := updateInstanceState(conn, d.Id(), o.(string), n.(string), d.Get("force").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing EC2 Instance State (%s) from state; the instance is left in its current state", d.Id())
+
+	return nil
+}
+
+// updateInstanceState starts or stops the EC2 Instance so that it converges on the desired state.
+// Instances that are part-way through a transition are first allowed to settle.
+func updateInstanceState(conn *ec2.EC2, id string, currentState string, desiredState string, force bool, timeout time.Duration) error {
+	switch currentState {
+	case ec2.InstanceStateNamePending:
+		if err := WaitForInstanceStarting(conn, id, timeout); err != nil {
+			return err
+		}
+
+		currentState = ec2.InstanceStateNameRunning
+	case ec2.InstanceStateNameStopping:
+		if err := WaitForInstanceStopping(conn, id, timeout); err != nil {
+			return err
+		}
+
+		currentState = ec2.InstanceStateNameStopped
+	}
+
+	if currentState == desiredState {
+		return nil
+	}
+
+	switch desiredState {
+	case ec2.InstanceStateNameRunning:
+		input := &ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{id}),
+		}
+
+		log.Printf("[DEBUG] Starting EC2 Instance: %s", input)
+		if _, err := conn.StartInstances(input); err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+		}
+
+		if err := WaitForInstanceStarting(conn, id, timeout); err != nil {
+			return err
+		}
+	case ec2.InstanceStateNameStopped:
+		input := &ec2.StopInstancesInput{
+			Force:       aws.Bool(force),
+			InstanceIds: aws.StringSlice([]string{id}),
+		}
+
+		log.Printf("[DEBUG] Stopping EC2 Instance: %s", input)
+		if _, err := conn.StopInstances(input); err != nil {
+			return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+		}
+
+		if err := WaitForInstanceStopping(conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -584,6 +585,29 @@ func ResourceLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"private_dns_name_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_resource_name_dns_aaaa_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_resource_name_dns_a_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"hostname_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(ec2.HostnameType_Values(), false),
+						},
+					},
+				},
+			},
+
 			"ram_disk_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -848,6 +872,10 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting enclave_options: %s", err)
 	}
 
+	if err := d.Set("private_dns_name_options", flattenLaunchTemplatePrivateDNSNameOptions(ltData.PrivateDnsNameOptions)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_options: %w", err)
+	}
+
 	if err := d.Set("monitoring", getMonitoring(ltData.Monitoring)); err != nil {
 		return fmt.Errorf("error setting monitoring: %s", err)
 	}
@@ -1183,6 +1211,48 @@ func getEnclaveOptions(m *ec2.LaunchTemplateEnclaveOptions) []interface{} {
 	return s
 }
 
+func expandLaunchTemplatePrivateDNSNameOptions(tfList cty.Value) *ec2.LaunchTemplatePrivateDnsNameOptionsRequest {
+	if !tfList.IsKnown() || tfList.IsNull() || tfList.LengthInt() == 0 {
+		return nil
+	}
+
+	tfMap := tfList.Index(cty.NumberIntVal(0))
+
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	apiObject := &ec2.LaunchTemplatePrivateDnsNameOptionsRequest{}
+
+	if v := tfMap.GetAttr("enable_resource_name_dns_aaaa_record"); v.IsKnown() && !v.IsNull() {
+		apiObject.EnableResourceNameDnsAAAARecord = aws.Bool(v.True())
+	}
+
+	if v := tfMap.GetAttr("enable_resource_name_dns_a_record"); v.IsKnown() && !v.IsNull() {
+		apiObject.EnableResourceNameDnsARecord = aws.Bool(v.True())
+	}
+
+	if v := tfMap.GetAttr("hostname_type"); v.IsKnown() && !v.IsNull() && v.AsString() != "" {
+		apiObject.HostnameType = aws.String(v.AsString())
+	}
+
+	return apiObject
+}
+
+func flattenLaunchTemplatePrivateDNSNameOptions(apiObject *ec2.LaunchTemplatePrivateDnsNameOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_resource_name_dns_aaaa_record": aws.BoolValue(apiObject.EnableResourceNameDnsAAAARecord),
+		"enable_resource_name_dns_a_record":    aws.BoolValue(apiObject.EnableResourceNameDnsARecord),
+		"hostname_type":                        aws.StringValue(apiObject.HostnameType),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func getMonitoring(m *ec2.LaunchTemplatesMonitoring) []interface{} {
 	s := []interface{}{}
 	if m != nil {
@@ -1470,6 +1540,9 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		}
 	}
 
+	// Only send the options that are set in configuration so that subnet defaults apply to the rest.
+	opts.PrivateDnsNameOptions = expandLaunchTemplatePrivateDNSNameOptions(d.GetRawConfig().GetAttr("private_dns_name_options"))
+
 	if v, ok := d.GetOk("monitoring"); ok {
 		m := v.([]interface{})
 		if len(m) > 0 && m[0] != nil {
@@ -1919,6 +1992,7 @@ var updateKeys = []string{
 	"monitoring",
 	"network_interfaces",
 	"placement",
+	"private_dns_name_options",
 	"ram_disk_id",
 	"security_group_names",
 	"tag_specifications",
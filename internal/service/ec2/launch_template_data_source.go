@@ -253,6 +253,26 @@ func DataSourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
+			"private_dns_name_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_resource_name_dns_aaaa_record": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_resource_name_dns_a_record": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hostname_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"monitoring": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -553,6 +573,10 @@ func dataSourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting enclave_options: %w", err)
 	}
 
+	if err := d.Set("private_dns_name_options", flattenLaunchTemplatePrivateDNSNameOptions(ltData.PrivateDnsNameOptions)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_options: %w", err)
+	}
+
 	if err := d.Set("monitoring", getMonitoring(ltData.Monitoring)); err != nil {
 		return fmt.Errorf("error setting monitoring: %w", err)
 	}
@@ -180,6 +180,30 @@ func TestAccEC2LaunchTemplateDataSource_enclaveOptions(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplateDataSource_privateDNSNameOptions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateDataSourceConfig_privateDNSNameOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_dns_name_options.#", resourceName, "private_dns_name_options.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_dns_name_options.0.enable_resource_name_dns_aaaa_record", resourceName, "private_dns_name_options.0.enable_resource_name_dns_aaaa_record"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_dns_name_options.0.enable_resource_name_dns_a_record", resourceName, "private_dns_name_options.0.enable_resource_name_dns_a_record"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_dns_name_options.0.hostname_type", resourceName, "private_dns_name_options.0.hostname_type"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplateDataSource_associatePublicIPAddress(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
@@ -397,6 +421,24 @@ data "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateDataSourceConfig_privateDNSNameOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  private_dns_name_options {
+    enable_resource_name_dns_aaaa_record = true
+    enable_resource_name_dns_a_record    = false
+    hostname_type                        = "resource-name"
+  }
+}
+
+data "aws_launch_template" "test" {
+  name = aws_launch_template.test.name
+}
+`, rName)
+}
+
 func testAccLaunchTemplateDataSourceConfig_associatePublicIPAddress(rName, associatePublicIPAddress string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -0,0 +1,453 @@
+package ec2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// DescribeSecurityGroupReferences accepts a limited number of group IDs per call.
+const securityGroupReferencesBatchSize = 100
+
+func DataSourceSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecurityGroupRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"referenced_by": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"referencing_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"referencing_vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_peering_connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix_list_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_security_group_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stale": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"to_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_peering_connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeSecurityGroupsInput{}
+
+	input.Filters = append(input.Filters, BuildTagFilterList(
+		Tags(tftags.New(d.Get("tags").(map[string]interface{}))),
+	)...)
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	securityGroups, err := FindSecurityGroups(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Security Groups: %w", err)
+	}
+
+	var securityGroupIDs []string
+	vpcIDs := make(map[string]struct{})
+
+	for _, v := range securityGroups {
+		securityGroupIDs = append(securityGroupIDs, aws.StringValue(v.GroupId))
+
+		if vpcID := aws.StringValue(v.VpcId); vpcID != "" {
+			vpcIDs[vpcID] = struct{}{}
+		}
+	}
+
+	// Stale rules reference security groups in a peer VPC whose peering connection has been deleted.
+	staleRules := make(map[string]map[string]interface{})
+
+	for vpcID := range vpcIDs {
+		output, err := FindStaleSecurityGroups(conn, &ec2.DescribeStaleSecurityGroupsInput{
+			VpcId: aws.String(vpcID),
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 VPC (%s) stale Security Groups: %w", vpcID, err)
+		}
+
+		for _, v := range output {
+			for _, rule := range flattenStaleSecurityGroupRules(v, vpcID) {
+				staleRules[securityGroupRuleKey(rule)] = rule
+			}
+		}
+	}
+
+	var rules []interface{}
+	referencedBy := make(map[string]map[string]interface{})
+
+	for _, v := range securityGroups {
+		for _, rule := range flattenSecurityGroupRules(v) {
+			key := securityGroupRuleKey(rule)
+
+			if _, ok := staleRules[key]; ok {
+				rule["stale"] = true
+				delete(staleRules, key)
+			}
+
+			rules = append(rules, rule)
+
+			if peerID := rule["source_security_group_id"].(string); peerID != "" {
+				reference := map[string]interface{}{
+					"referencing_security_group_id": rule["security_group_id"],
+					"referencing_vpc_id":            rule["vpc_id"],
+					"security_group_id":             peerID,
+					"vpc_peering_connection_id":     rule["vpc_peering_connection_id"],
+				}
+				referencedBy[securityGroupReferenceKey(reference)] = reference
+			}
+		}
+	}
+
+	// DescribeStaleSecurityGroups is scoped to the VPC, so drop stale rules for security groups that weren't matched.
+	matchedIDs := make(map[string]struct{}, len(securityGroupIDs))
+	for _, v := range securityGroupIDs {
+		matchedIDs[v] = struct{}{}
+	}
+
+	var staleKeys []string
+	for k, v := range staleRules {
+		if _, ok := matchedIDs[v["security_group_id"].(string)]; !ok {
+			continue
+		}
+
+		staleKeys = append(staleKeys, k)
+	}
+	sort.Strings(staleKeys)
+
+	for _, k := range staleKeys {
+		rules = append(rules, staleRules[k])
+	}
+
+	for i := 0; i < len(securityGroupIDs); i += securityGroupReferencesBatchSize {
+		j := i + securityGroupReferencesBatchSize
+		if j > len(securityGroupIDs) {
+			j = len(securityGroupIDs)
+		}
+
+		output, err := findSecurityGroupReferencesBatch(conn, securityGroupIDs[i:j])
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Security Group references: %w", err)
+		}
+
+		for _, v := range output {
+			reference := map[string]interface{}{
+				"referencing_security_group_id": "",
+				"referencing_vpc_id":            aws.StringValue(v.ReferencingVpcId),
+				"security_group_id":             aws.StringValue(v.GroupId),
+				"vpc_peering_connection_id":     aws.StringValue(v.VpcPeeringConnectionId),
+			}
+			referencedBy[securityGroupReferenceKey(reference)] = reference
+		}
+	}
+
+	var keys []string
+	for k := range referencedBy {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var references []interface{}
+	for _, k := range keys {
+		references = append(references, referencedBy[k])
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", securityGroupIDs)
+
+	if err := d.Set("referenced_by", references); err != nil {
+		return fmt.Errorf("error setting referenced_by: %w", err)
+	}
+
+	if err := d.Set("rules", rules); err != nil {
+		return fmt.Errorf("error setting rules: %w", err)
+	}
+
+	return nil
+}
+
+// findSecurityGroupReferencesBatch returns the references to the specified security groups.
+// If any of the security groups no longer exists, the references are read one security group at a time
+// so that only the missing security group is skipped.
+func findSecurityGroupReferencesBatch(conn *ec2.EC2, ids []string) ([]*ec2.SecurityGroupReference, error) {
+	output, err := FindSecurityGroupReferences(conn, &ec2.DescribeSecurityGroupReferencesInput{
+		GroupId: aws.StringSlice(ids),
+	})
+
+	if !tfresource.NotFound(err) {
+		return output, err
+	}
+
+	output = nil
+
+	for _, id := range ids {
+		references, err := FindSecurityGroupReferences(conn, &ec2.DescribeSecurityGroupReferencesInput{
+			GroupId: aws.StringSlice([]string{id}),
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, references...)
+	}
+
+	return output, nil
+}
+
+// flattenSecurityGroupRules returns one flattened rule per peer (CIDR block, prefix list or security group)
+// of each of the specified security group's ingress and egress permissions.
+func flattenSecurityGroupRules(sg *ec2.SecurityGroup) []map[string]interface{} {
+	var rules []map[string]interface{}
+
+	for _, v := range []struct {
+		ruleType    string
+		permissions []*ec2.IpPermission
+	}{
+		{"ingress", sg.IpPermissions},
+		{"egress", sg.IpPermissionsEgress},
+	} {
+		for _, perm := range v.permissions {
+			if perm == nil {
+				continue
+			}
+
+			newRule := func() map[string]interface{} {
+				return newSecurityGroupRuleMap(aws.StringValue(sg.GroupId), aws.StringValue(sg.VpcId), v.ruleType, perm.IpProtocol, perm.FromPort, perm.ToPort)
+			}
+
+			for _, r := range perm.IpRanges {
+				rule := newRule()
+				rule["cidr_block"] = aws.StringValue(r.CidrIp)
+				rule["description"] = aws.StringValue(r.Description)
+				rules = append(rules, rule)
+			}
+
+			for _, r := range perm.Ipv6Ranges {
+				rule := newRule()
+				rule["ipv6_cidr_block"] = aws.StringValue(r.CidrIpv6)
+				rule["description"] = aws.StringValue(r.Description)
+				rules = append(rules, rule)
+			}
+
+			for _, r := range perm.PrefixListIds {
+				rule := newRule()
+				rule["prefix_list_id"] = aws.StringValue(r.PrefixListId)
+				rule["description"] = aws.StringValue(r.Description)
+				rules = append(rules, rule)
+			}
+
+			for _, r := range perm.UserIdGroupPairs {
+				rule := newRule()
+				rule["source_security_group_id"] = aws.StringValue(r.GroupId)
+				rule["source_security_group_owner_id"] = aws.StringValue(r.UserId)
+				rule["vpc_peering_connection_id"] = aws.StringValue(r.VpcPeeringConnectionId)
+				rule["description"] = aws.StringValue(r.Description)
+				rule["stale"] = aws.StringValue(r.PeeringStatus) == "deleted"
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return rules
+}
+
+// flattenStaleSecurityGroupRules returns one flattened rule per peer of each of the specified stale security group's
+// ingress and egress permissions. All returned rules are marked as stale.
+func flattenStaleSecurityGroupRules(sg *ec2.StaleSecurityGroup, vpcID string) []map[string]interface{} {
+	var rules []map[string]interface{}
+
+	for _, v := range []struct {
+		ruleType    string
+		permissions []*ec2.StaleIpPermission
+	}{
+		{"ingress", sg.StaleIpPermissions},
+		{"egress", sg.StaleIpPermissionsEgress},
+	} {
+		for _, perm := range v.permissions {
+			if perm == nil {
+				continue
+			}
+
+			newRule := func() map[string]interface{} {
+				rule := newSecurityGroupRuleMap(aws.StringValue(sg.GroupId), vpcID, v.ruleType, perm.IpProtocol, perm.FromPort, perm.ToPort)
+				rule["stale"] = true
+				return rule
+			}
+
+			for _, r := range perm.IpRanges {
+				rule := newRule()
+				rule["cidr_block"] = aws.StringValue(r)
+				rules = append(rules, rule)
+			}
+
+			for _, r := range perm.PrefixListIds {
+				rule := newRule()
+				rule["prefix_list_id"] = aws.StringValue(r)
+				rules = append(rules, rule)
+			}
+
+			for _, r := range perm.UserIdGroupPairs {
+				rule := newRule()
+				rule["source_security_group_id"] = aws.StringValue(r.GroupId)
+				rule["source_security_group_owner_id"] = aws.StringValue(r.UserId)
+				rule["vpc_peering_connection_id"] = aws.StringValue(r.VpcPeeringConnectionId)
+				rule["description"] = aws.StringValue(r.Description)
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return rules
+}
+
+func newSecurityGroupRuleMap(groupID, vpcID, ruleType string, protocol *string, fromPort, toPort *int64) map[string]interface{} {
+	return map[string]interface{}{
+		"cidr_block":                     "",
+		"description":                    "",
+		"from_port":                      int(aws.Int64Value(fromPort)),
+		"ipv6_cidr_block":                "",
+		"prefix_list_id":                 "",
+		"protocol":                       ProtocolForValue(aws.StringValue(protocol)),
+		"security_group_id":              groupID,
+		"source_security_group_id":       "",
+		"source_security_group_owner_id": "",
+		"stale":                          false,
+		"to_port":                        int(aws.Int64Value(toPort)),
+		"type":                           ruleType,
+		"vpc_id":                         vpcID,
+		"vpc_peering_connection_id":      "",
+	}
+}
+
+func securityGroupRuleKey(rule map[string]interface{}) string {
+	return strings.Join([]string{
+		rule["security_group_id"].(string),
+		rule["type"].(string),
+		rule["protocol"].(string),
+		fmt.Sprintf("%d", rule["from_port"].(int)),
+		fmt.Sprintf("%d", rule["to_port"].(int)),
+		rule["cidr_block"].(string),
+		rule["ipv6_cidr_block"].(string),
+		rule["prefix_list_id"].(string),
+		rule["source_security_group_id"].(string),
+	}, "|")
+}
+
+func securityGroupReferenceKey(reference map[string]interface{}) string {
+	return strings.Join([]string{
+		reference["security_group_id"].(string),
+		reference["referencing_security_group_id"].(string),
+		reference["referencing_vpc_id"].(string),
+		reference["vpc_peering_connection_id"].(string),
+	}, "|")
+}
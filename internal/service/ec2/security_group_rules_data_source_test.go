@@ -0,0 +1,118 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2SecurityGroupRulesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroupRulesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.*", map[string]string{
+						"type":        "ingress",
+						"protocol":    "tcp",
+						"from_port":   "443",
+						"to_port":     "443",
+						"cidr_block":  "10.0.0.0/16",
+						"description": "HTTPS from VPC",
+						"stale":       "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.*", map[string]string{
+						"type":      "egress",
+						"protocol":  "-1",
+						"from_port": "0",
+						"to_port":   "0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "rules.*.source_security_group_id", "aws_security_group.test1", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "referenced_by.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "referenced_by.0.security_group_id", "aws_security_group.test1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "referenced_by.0.referencing_security_group_id", "aws_security_group.test2", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "referenced_by.0.referencing_vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityGroupRulesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test1" {
+  name   = "%[1]s-1"
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    description = "HTTPS from VPC"
+    from_port   = 443
+    to_port     = 443
+    protocol    = "tcp"
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test2" {
+  name   = "%[1]s-2"
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    description     = "PostgreSQL from test1"
+    from_port       = 5432
+    to_port         = 5432
+    protocol        = "tcp"
+    security_groups = [aws_security_group.test1.id]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_security_group_rules" "test" {
+  filter {
+    name   = "group-id"
+    values = [aws_security_group.test1.id, aws_security_group.test2.id]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,97 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccEC2SerialConsoleAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_serial_console_access.test"
+
+	// Serial console access is an account-wide, per-Region setting, so these tests must not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSerialConsoleAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSerialConsoleAccessConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSerialConsoleAccess(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSerialConsoleAccessConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSerialConsoleAccess(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSerialConsoleAccessDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(output.SerialConsoleAccessEnabled) {
+		return fmt.Errorf("EC2 Serial Console Access not disabled on resource removal")
+	}
+
+	return nil
+}
+
+func testAccCheckSerialConsoleAccess(n string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.SerialConsoleAccessEnabled); got != enabled {
+			return fmt.Errorf("EC2 Serial Console Access is not in expected state (%t)", got)
+		}
+
+		return nil
+	}
+}
+
+func testAccSerialConsoleAccessConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_serial_console_access" "test" {
+  enabled = %[1]t
+}
+`, enabled)
+}
@@ -1,7 +1,6 @@
 package ec2
 
 import (
-	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -86,6 +85,7 @@ func ResourceVPCEndpoint() *schema.Resource {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
+				Description: "A policy to attach to the endpoint that controls access to the service. Conflicts with the aws_vpc_endpoint_policy resource.",
 			},
 			"prefix_list_id": {
 				Type:     schema.TypeString,
@@ -108,11 +108,12 @@ func ResourceVPCEndpoint() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"security_group_ids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The ID of one or more security groups to associate with the network interface. Conflicts with the aws_vpc_endpoint_security_group_association resource.",
 			},
 			"service_name": {
 				Type:     schema.TypeString,
@@ -157,11 +158,8 @@ func ResourceVPCEndpoint() *schema.Resource {
 }
 
 func resourceVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	if d.Get("vpc_endpoint_type").(string) == ec2.VpcEndpointTypeInterface &&
-		d.Get("security_group_ids").(*schema.Set).Len() == 0 {
-		return errors.New("An Interface VPC Endpoint must always have at least one Security Group")
-	}
-
+	// Interface VPC Endpoints created without any security groups are associated with the VPC's default security group,
+	// which can then be replaced by aws_vpc_endpoint_security_group_association.
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
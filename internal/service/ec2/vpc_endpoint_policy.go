@@ -0,0 +1,161 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCEndpointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEndpointPolicyPut,
+		Read:   resourceVPCEndpointPolicyRead,
+		Update: resourceVPCEndpointPolicyPut,
+		Delete: resourceVPCEndpointPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				Description: "A policy to attach to the endpoint that controls access to the service. Conflicts with the policy argument of aws_vpc_endpoint; do not manage the same VPC endpoint's policy with both.",
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceVPCEndpointPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	endpointID := d.Get("vpc_endpoint_id").(string)
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(endpointID),
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy"))
+
+	if err != nil {
+		return fmt.Errorf("policy contains an invalid JSON: %w", err)
+	}
+
+	if policy == "" {
+		input.ResetPolicy = aws.Bool(true)
+	} else {
+		input.PolicyDocument = aws.String(policy)
+	}
+
+	log.Printf("[DEBUG] Updating VPC Endpoint Policy: %s", input)
+	_, err = conn.ModifyVpcEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating VPC Endpoint (%s) policy: %w", endpointID, err)
+	}
+
+	d.SetId(endpointID)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	_, err = WaitVPCEndpointAvailable(conn, endpointID, timeout)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint (%s) to become available: %w", endpointID, err)
+	}
+
+	return resourceVPCEndpointPolicyRead(d, meta)
+}
+
+func resourceVPCEndpointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpce, err := FindVPCEndpointByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Endpoint Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Endpoint Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("vpc_endpoint_id", d.Id())
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(vpce.PolicyDocument))
+
+	if err != nil {
+		return fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceVPCEndpointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+		ResetPolicy:   aws.Bool(true),
+	}
+
+	log.Printf("[DEBUG] Resetting VPC Endpoint Policy: %s", d.Id())
+	_, err := conn.ModifyVpcEndpoint(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidVpcEndpointIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting VPC Endpoint (%s) policy: %w", d.Id(), err)
+	}
+
+	_, err = WaitVPCEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint (%s) to become available: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2VPCEndpointPolicy_basic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_id", "aws_vpc_endpoint.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"dynamodb:DescribeTable"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcEndpointPolicyConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"dynamodb:\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCEndpointPolicy_disappears(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCEndpointPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCEndpointPolicy_disappears_endpoint(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCEndpoint(), "aws_vpc_endpoint.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCEndpointPolicy_reset(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_id", "aws_vpc_endpoint.test", "id"),
+				),
+			},
+			{
+				Config: testAccVpcEndpointPolicyConfigBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					testAccCheckVpcEndpointPolicyIsDefault(&endpoint),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckVpcEndpointPolicyIsDefault checks that the VPC endpoint policy was restored to the
+// service default ("full access") policy.
+func testAccCheckVpcEndpointPolicyIsDefault(endpoint *ec2.VpcEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVPCEndpointByID(conn, aws.StringValue(endpoint.VpcEndpointId))
+
+		if err != nil {
+			return err
+		}
+
+		if policy := aws.StringValue(output.PolicyDocument); regexp.MustCompile(`"dynamodb:DescribeTable"`).MatchString(policy) {
+			return fmt.Errorf("VPC Endpoint (%s) policy not reset: %s", aws.StringValue(endpoint.VpcEndpointId), policy)
+		}
+
+		return nil
+	}
+}
+
+func testAccVpcEndpointPolicyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_vpc_endpoint_service" "test" {
+  service = "dynamodb"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  service_name = data.aws_vpc_endpoint_service.test.service_name
+  vpc_id       = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+`, rName)
+}
+
+func testAccVpcEndpointPolicyConfigBasic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVpcEndpointPolicyConfigBase(rName),
+		`
+resource "aws_vpc_endpoint_policy" "test" {
+  vpc_endpoint_id = aws_vpc_endpoint.test.id
+  policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [
+      {
+        "Sid" : "ReadOnly",
+        "Principal" : "*",
+        "Action" : [
+          "dynamodb:DescribeTable",
+          "dynamodb:ListTables"
+        ],
+        "Effect" : "Allow",
+        "Resource" : "*"
+      }
+    ]
+  })
+}
+`)
+}
+
+func testAccVpcEndpointPolicyConfigUpdated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVpcEndpointPolicyConfigBase(rName),
+		`
+resource "aws_vpc_endpoint_policy" "test" {
+  vpc_endpoint_id = aws_vpc_endpoint.test.id
+  policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [
+      {
+        "Sid" : "AllowAll",
+        "Principal" : "*",
+        "Action" : [
+          "dynamodb:*"
+        ],
+        "Effect" : "Allow",
+        "Resource" : "*"
+      }
+    ]
+  })
+}
+`)
+}
@@ -0,0 +1,217 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceVPCEndpointSecurityGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEndpointSecurityGroupAssociationCreate,
+		Read:   resourceVPCEndpointSecurityGroupAssociationRead,
+		Delete: resourceVPCEndpointSecurityGroupAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVPCEndpointSecurityGroupAssociationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"replace_default_association": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether this association should replace the association with the VPC's default security group that is created when no security groups are specified during VPC endpoint creation. At most 1 association per-VPC endpoint should be configured with this set to true.",
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the security group to be associated with the VPC endpoint. Conflicts with the security_group_ids argument of aws_vpc_endpoint; do not manage the same VPC endpoint's security groups with both.",
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceVPCEndpointSecurityGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	endpointID := d.Get("vpc_endpoint_id").(string)
+	securityGroupID := d.Get("security_group_id").(string)
+	replaceDefaultAssociation := d.Get("replace_default_association").(bool)
+	// Human friendly ID for error messages since d.Id() is non-descriptive
+	id := fmt.Sprintf("%s/%s", endpointID, securityGroupID)
+
+	defaultSecurityGroupID := ""
+	if replaceDefaultAssociation {
+		vpcEndpoint, err := FindVPCEndpointByID(conn, endpointID)
+
+		if err != nil {
+			return fmt.Errorf("error reading VPC Endpoint (%s): %w", endpointID, err)
+		}
+
+		vpcID := aws.StringValue(vpcEndpoint.VpcId)
+
+		defaultSecurityGroup, err := FindVPCDefaultSecurityGroup(conn, vpcID)
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 VPC (%s) default Security Group: %w", vpcID, err)
+		}
+
+		defaultSecurityGroupID = aws.StringValue(defaultSecurityGroup.GroupId)
+
+		if defaultSecurityGroupID == securityGroupID {
+			return fmt.Errorf("VPC Endpoint (%s) default Security Group (%s) cannot be replaced with itself", endpointID, defaultSecurityGroupID)
+		}
+	}
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId:       aws.String(endpointID),
+		AddSecurityGroupIds: aws.StringSlice([]string{securityGroupID}),
+	}
+
+	if defaultSecurityGroupID != "" {
+		input.RemoveSecurityGroupIds = aws.StringSlice([]string{defaultSecurityGroupID})
+	}
+
+	log.Printf("[DEBUG] Creating VPC Endpoint Security Group Association: %s", input)
+
+	// Prevent concurrent security group association requests.
+	mk := "vpc_endpoint_security_group_association_" + endpointID
+	conns.GlobalMutexKV.Lock(mk)
+	defer conns.GlobalMutexKV.Unlock(mk)
+
+	_, err := conn.ModifyVpcEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Endpoint Security Group Association (%s): %w", id, err)
+	}
+
+	d.SetId(VPCEndpointSecurityGroupAssociationCreateID(endpointID, securityGroupID))
+
+	_, err = WaitVPCEndpointAvailable(conn, endpointID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint (%s) to become available: %w", endpointID, err)
+	}
+
+	return resourceVPCEndpointSecurityGroupAssociationRead(d, meta)
+}
+
+func resourceVPCEndpointSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	endpointID := d.Get("vpc_endpoint_id").(string)
+	securityGroupID := d.Get("security_group_id").(string)
+	// Human friendly ID for error messages since d.Id() is non-descriptive
+	id := fmt.Sprintf("%s/%s", endpointID, securityGroupID)
+
+	err := FindVPCEndpointSecurityGroupAssociationExists(conn, endpointID, securityGroupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Endpoint Security Group Association (%s) not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Endpoint Security Group Association (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func resourceVPCEndpointSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	endpointID := d.Get("vpc_endpoint_id").(string)
+	securityGroupID := d.Get("security_group_id").(string)
+	replaceDefaultAssociation := d.Get("replace_default_association").(bool)
+	// Human friendly ID for error messages since d.Id() is non-descriptive
+	id := fmt.Sprintf("%s/%s", endpointID, securityGroupID)
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId:          aws.String(endpointID),
+		RemoveSecurityGroupIds: aws.StringSlice([]string{securityGroupID}),
+	}
+
+	if replaceDefaultAssociation {
+		vpcEndpoint, err := FindVPCEndpointByID(conn, endpointID)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading VPC Endpoint (%s): %w", endpointID, err)
+		}
+
+		vpcID := aws.StringValue(vpcEndpoint.VpcId)
+
+		defaultSecurityGroup, err := FindVPCDefaultSecurityGroup(conn, vpcID)
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 VPC (%s) default Security Group: %w", vpcID, err)
+		}
+
+		// Restore the VPC's default security group so that the VPC endpoint is never left without one.
+		input.AddSecurityGroupIds = aws.StringSlice([]string{aws.StringValue(defaultSecurityGroup.GroupId)})
+	}
+
+	mk := "vpc_endpoint_security_group_association_" + endpointID
+	conns.GlobalMutexKV.Lock(mk)
+	defer conns.GlobalMutexKV.Unlock(mk)
+
+	log.Printf("[DEBUG] Deleting VPC Endpoint Security Group Association: %s", id)
+	_, err := conn.ModifyVpcEndpoint(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidVpcEndpointIdNotFound) || tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound) || tfawserr.ErrCodeEquals(err, ErrCodeInvalidParameter) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Endpoint Security Group Association (%s): %w", id, err)
+	}
+
+	_, err = WaitVPCEndpointAvailable(conn, endpointID, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint (%s) to become available: %w", endpointID, err)
+	}
+
+	return nil
+}
+
+func resourceVPCEndpointSecurityGroupAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'vpc-endpoint-id/security-group-id'", d.Id())
+	}
+
+	endpointID := parts[0]
+	securityGroupID := parts[1]
+	log.Printf("[DEBUG] Importing VPC Endpoint (%s) Security Group (%s) Association", endpointID, securityGroupID)
+
+	d.SetId(VPCEndpointSecurityGroupAssociationCreateID(endpointID, securityGroupID))
+	d.Set("vpc_endpoint_id", endpointID)
+	d.Set("security_group_id", securityGroupID)
+
+	return []*schema.ResourceData{d}, nil
+}
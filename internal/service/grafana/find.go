@@ -0,0 +1,116 @@
+package grafana
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindWorkspaceByID(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	input := &managedgrafana.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWorkspace(input)
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workspace == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workspace, nil
+}
+
+func FindWorkspaceAuthenticationByID(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.AuthenticationDescription, error) {
+	input := &managedgrafana.DescribeWorkspaceAuthenticationInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWorkspaceAuthentication(input)
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Authentication == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Authentication, nil
+}
+
+func FindWorkspaceSAMLConfigurationByID(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.SamlAuthentication, error) {
+	output, err := FindWorkspaceAuthenticationByID(conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Saml == nil || output.Saml.Configuration == nil {
+		return nil, &resource.NotFoundError{}
+	}
+
+	return output.Saml, nil
+}
+
+// FindRoleAssociationsByRoleAndWorkspaceID returns the IDs of the SSO users and groups that have the specified role
+// in the specified workspace, keyed by user type.
+func FindRoleAssociationsByRoleAndWorkspaceID(conn *managedgrafana.ManagedGrafana, role, workspaceID string) (map[string][]string, error) {
+	input := &managedgrafana.ListPermissionsInput{
+		WorkspaceId: aws.String(workspaceID),
+	}
+	output := make(map[string][]string)
+
+	err := conn.ListPermissionsPages(input, func(page *managedgrafana.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v == nil || v.User == nil || aws.StringValue(v.Role) != role {
+				continue
+			}
+
+			userType := aws.StringValue(v.User.Type)
+			output[userType] = append(output[userType], aws.StringValue(v.User.Id))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
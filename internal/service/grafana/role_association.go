@@ -0,0 +1,192 @@
+package grafana
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRoleAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRoleAssociationCreate,
+		Read:   resourceRoleAssociationRead,
+		Delete: resourceRoleAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"group_ids", "user_ids"},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.Role_Values(), false),
+			},
+			"user_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"group_ids", "user_ids"},
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRoleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	role := d.Get("role").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	id := RoleAssociationCreateResourceID(workspaceID, role)
+
+	if err := updateRoleAssociation(conn, managedgrafana.UpdateActionAdd, role, workspaceID, d.Get("group_ids").(*schema.Set), d.Get("user_ids").(*schema.Set)); err != nil {
+		return fmt.Errorf("error creating Grafana Role Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRoleAssociationRead(d, meta)
+}
+
+func resourceRoleAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, role, err := RoleAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	associations, err := FindRoleAssociationsByRoleAndWorkspaceID(conn, role, workspaceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Role Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Grafana Role Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("group_ids", associations[managedgrafana.UserTypeSsoGroup])
+	d.Set("role", role)
+	d.Set("user_ids", associations[managedgrafana.UserTypeSsoUser])
+	d.Set("workspace_id", workspaceID)
+
+	return nil
+}
+
+func resourceRoleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, role, err := RoleAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Grafana Role Association: %s", d.Id())
+	err = updateRoleAssociation(conn, managedgrafana.UpdateActionRevoke, role, workspaceID, d.Get("group_ids").(*schema.Set), d.Get("user_ids").(*schema.Set))
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Grafana Role Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateRoleAssociation adds or revokes the specified role for the specified SSO groups and users.
+func updateRoleAssociation(conn *managedgrafana.ManagedGrafana, action, role, workspaceID string, groupIDs, userIDs *schema.Set) error {
+	var users []*managedgrafana.User
+
+	for _, v := range flex.ExpandStringSet(groupIDs) {
+		users = append(users, &managedgrafana.User{
+			Id:   v,
+			Type: aws.String(managedgrafana.UserTypeSsoGroup),
+		})
+	}
+
+	for _, v := range flex.ExpandStringSet(userIDs) {
+		users = append(users, &managedgrafana.User{
+			Id:   v,
+			Type: aws.String(managedgrafana.UserTypeSsoUser),
+		})
+	}
+
+	input := &managedgrafana.UpdatePermissionsInput{
+		UpdateInstructionBatch: []*managedgrafana.UpdateInstruction{{
+			Action: aws.String(action),
+			Role:   aws.String(role),
+			Users:  users,
+		}},
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Updating Grafana Workspace permissions: %s", input)
+	output, err := conn.UpdatePermissions(input)
+
+	if err != nil {
+		return err
+	}
+
+	// Per-user failures are reported in the response rather than as an error.
+	var errs *multierror.Error
+
+	for _, v := range output.Errors {
+		if v == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("(%d) %s", aws.Int64Value(v.Code), aws.StringValue(v.Message)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+const roleAssociationResourceIDSeparator = ","
+
+func RoleAssociationCreateResourceID(workspaceID, role string) string {
+	parts := []string{workspaceID, role}
+	id := strings.Join(parts, roleAssociationResourceIDSeparator)
+
+	return id
+}
+
+func RoleAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, roleAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sROLE", id, roleAssociationResourceIDSeparator)
+}
@@ -0,0 +1,211 @@
+package grafana_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGrafanaRoleAssociation_usersAdmin(t *testing.T) {
+	resourceName := "aws_grafana_role_association.test"
+	workspaceResourceName := "aws_grafana_workspace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userID := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t)
+			testAccPreCheckSSOAdminInstances(t)
+			testAccPreCheckIdentityStoreUserID(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAssociationUsersConfig(rName, managedgrafana.RoleAdmin, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "role", managedgrafana.RoleAdmin),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_ids.*", userID),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGrafanaRoleAssociation_groupsEditor(t *testing.T) {
+	resourceName := "aws_grafana_role_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t)
+			testAccPreCheckSSOAdminInstances(t)
+			testAccPreCheckIdentityStoreGroupID(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAssociationGroupsConfig(rName, managedgrafana.RoleEditor, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "group_ids.*", groupID),
+					resource.TestCheckResourceAttr(resourceName, "role", managedgrafana.RoleEditor),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoleAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Grafana Role Association ID is set")
+		}
+
+		workspaceID, role, err := tfgrafana.RoleAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+		_, err = tfgrafana.FindRoleAssociationsByRoleAndWorkspaceID(conn, role, workspaceID)
+
+		return err
+	}
+}
+
+func testAccCheckRoleAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_grafana_role_association" {
+			continue
+		}
+
+		workspaceID, role, err := tfgrafana.RoleAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgrafana.FindRoleAssociationsByRoleAndWorkspaceID(conn, role, workspaceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Grafana Role Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheckSSOAdminInstances(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	var instances []*ssoadmin.InstanceMetadata
+	err := conn.ListInstancesPages(&ssoadmin.ListInstancesInput{}, func(page *ssoadmin.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		instances = append(instances, page.Instances...)
+
+		return !lastPage
+	})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if len(instances) == 0 {
+		t.Skip("skipping acceptance testing: No SSO Instance found.")
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccPreCheckIdentityStoreGroupID(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_ID") == "" {
+		t.Skip("AWS_IDENTITY_STORE_GROUP_ID env var must be set for Grafana Role Association acceptance test. " +
+			"This is required until ability to create groups in AWS SSO is added.")
+	}
+}
+
+func testAccPreCheckIdentityStoreUserID(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_USER_ID") == "" {
+		t.Skip("AWS_IDENTITY_STORE_USER_ID env var must be set for Grafana Role Association acceptance test. " +
+			"This is required until ability to create users in AWS SSO is added.")
+	}
+}
+
+func testAccRoleAssociationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["AWS_SSO"]
+  permission_type          = "SERVICE_MANAGED"
+  name                     = %[1]q
+}
+`, rName)
+}
+
+func testAccRoleAssociationUsersConfig(rName, role, userID string) string {
+	return acctest.ConfigCompose(testAccRoleAssociationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_grafana_role_association" "test" {
+  role         = %[1]q
+  user_ids     = [%[2]q]
+  workspace_id = aws_grafana_workspace.test.id
+}
+`, role, userID))
+}
+
+func testAccRoleAssociationGroupsConfig(rName, role, groupID string) string {
+	return acctest.ConfigCompose(testAccRoleAssociationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_grafana_role_association" "test" {
+  group_ids    = [%[2]q]
+  role         = %[1]q
+  workspace_id = aws_grafana_workspace.test.id
+}
+`, role, groupID))
+}
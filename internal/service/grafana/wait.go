@@ -0,0 +1,84 @@
+package grafana
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	workspaceCreatedTimeout = 20 * time.Minute
+	workspaceUpdatedTimeout = 20 * time.Minute
+	workspaceDeletedTimeout = 20 * time.Minute
+
+	workspaceSAMLConfigurationCreatedTimeout = 2 * time.Minute
+)
+
+func WaitWorkspaceCreated(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusCreating},
+		Target:  []string{managedgrafana.WorkspaceStatusActive},
+		Refresh: StatusWorkspaceStatus(conn, id),
+		Timeout: workspaceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitWorkspaceUpdated(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusUpdating, managedgrafana.WorkspaceStatusUpgrading},
+		Target:  []string{managedgrafana.WorkspaceStatusActive},
+		Refresh: StatusWorkspaceStatus(conn, id),
+		Timeout: workspaceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitWorkspaceDeleted(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusDeleting},
+		Target:  []string{},
+		Refresh: StatusWorkspaceStatus(conn, id),
+		Timeout: workspaceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitWorkspaceSAMLConfigurationCreated(conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.SamlAuthentication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.SamlConfigurationStatusNotConfigured},
+		Target:  []string{managedgrafana.SamlConfigurationStatusConfigured},
+		Refresh: StatusWorkspaceSAMLConfiguration(conn, id),
+		Timeout: workspaceSAMLConfigurationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.SamlAuthentication); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,139 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+)
+
+func TestAccGrafanaWorkspaceSAMLConfiguration_basic(t *testing.T) {
+	resourceName := "aws_grafana_workspace_saml_configuration.test"
+	workspaceResourceName := "aws_grafana_workspace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceSAMLConfigurationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_role_values.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.0", "editor"),
+					resource.TestCheckResourceAttrSet(resourceName, "idp_metadata_xml"),
+					resource.TestCheckResourceAttr(resourceName, "status", managedgrafana.SamlConfigurationStatusConfigured),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGrafanaWorkspaceSAMLConfiguration_update(t *testing.T) {
+	resourceName := "aws_grafana_workspace_saml_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceSAMLConfigurationAssertionsConfig(rName, 60, "mail", "groups"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_role_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_role_values.0", "admin"),
+					resource.TestCheckResourceAttr(resourceName, "email_assertion", "mail"),
+					resource.TestCheckResourceAttr(resourceName, "groups_assertion", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "login_validity_duration", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceSAMLConfigurationAssertionsConfig(rName, 120, "email", "group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email_assertion", "email"),
+					resource.TestCheckResourceAttr(resourceName, "groups_assertion", "group"),
+					resource.TestCheckResourceAttr(resourceName, "login_validity_duration", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceSAMLConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Grafana Workspace ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+		_, err := tfgrafana.FindWorkspaceSAMLConfigurationByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccWorkspaceSAMLConfigurationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["SAML"]
+  permission_type          = "SERVICE_MANAGED"
+  name                     = %[1]q
+}
+`, rName)
+}
+
+func testAccWorkspaceSAMLConfigurationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceSAMLConfigurationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_grafana_workspace_saml_configuration" "test" {
+  editor_role_values = ["editor"]
+  idp_metadata_xml   = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = %[1]q })
+  workspace_id       = aws_grafana_workspace.test.id
+}
+`, rName))
+}
+
+func testAccWorkspaceSAMLConfigurationAssertionsConfig(rName string, loginValidityDuration int, emailAssertion, groupsAssertion string) string {
+	return acctest.ConfigCompose(testAccWorkspaceSAMLConfigurationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_grafana_workspace_saml_configuration" "test" {
+  admin_role_values       = ["admin"]
+  editor_role_values      = ["editor"]
+  email_assertion         = %[3]q
+  groups_assertion        = %[4]q
+  idp_metadata_xml        = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = %[1]q })
+  login_validity_duration = %[2]d
+  workspace_id            = aws_grafana_workspace.test.id
+}
+`, rName, loginValidityDuration, emailAssertion, groupsAssertion))
+}
@@ -0,0 +1,25 @@
+package iam
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// exclusiveDiff returns the items that must be added to and removed from
+// the actual items for them to match the desired items exactly.
+func exclusiveDiff(desired *schema.Set, actual []string) (add []string, remove []string) {
+	current := schema.NewSet(schema.HashString, nil)
+
+	for _, v := range actual {
+		current.Add(v)
+	}
+
+	for _, v := range desired.Difference(current).List() {
+		add = append(add, v.(string))
+	}
+
+	for _, v := range current.Difference(desired).List() {
+		remove = append(remove, v.(string))
+	}
+
+	return add, remove
+}
@@ -1,6 +1,8 @@
 package iam
 
 import (
+	"fmt"
+	"net/url"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -171,3 +173,584 @@ func FindRoleByName(conn *iam.IAM, name string) (*iam.Role, error) {
 
 	return output.Role, nil
 }
+
+// FindGroupAttachedPolicyARNs returns the ARNs of all managed policies attached to the specified group.
+func FindGroupAttachedPolicyARNs(conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	var output []string
+
+	err := conn.ListAttachedGroupPoliciesPages(input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attachedPolicy := range page.AttachedPolicies {
+			if attachedPolicy == nil {
+				continue
+			}
+
+			output = append(output, aws.StringValue(attachedPolicy.PolicyArn))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRoleAttachedPolicyARNs returns the ARNs of all managed policies attached to the specified role.
+func FindRoleAttachedPolicyARNs(conn *iam.IAM, roleName string) ([]string, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	var output []string
+
+	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attachedPolicy := range page.AttachedPolicies {
+			if attachedPolicy == nil {
+				continue
+			}
+
+			output = append(output, aws.StringValue(attachedPolicy.PolicyArn))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindUserAttachedPolicyARNs returns the ARNs of all managed policies attached to the specified user.
+func FindUserAttachedPolicyARNs(conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	var output []string
+
+	err := conn.ListAttachedUserPoliciesPages(input, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attachedPolicy := range page.AttachedPolicies {
+			if attachedPolicy == nil {
+				continue
+			}
+
+			output = append(output, aws.StringValue(attachedPolicy.PolicyArn))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindGroupPolicyNames returns the names of all inline policies embedded in the specified group.
+func FindGroupPolicyNames(conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	var output []string
+
+	err := conn.ListGroupPoliciesPages(input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRolePolicyNames returns the names of all inline policies embedded in the specified role.
+func FindRolePolicyNames(conn *iam.IAM, roleName string) ([]string, error) {
+	input := &iam.ListRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	var output []string
+
+	err := conn.ListRolePoliciesPages(input, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindUserPolicyNames returns the names of all inline policies embedded in the specified user.
+func FindUserPolicyNames(conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	var output []string
+
+	err := conn.ListUserPoliciesPages(input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindVirtualMFADeviceBySerialNumber returns the virtual MFA device with the specified serial number.
+func FindVirtualMFADeviceBySerialNumber(conn *iam.IAM, serialNumber string) (*iam.VirtualMFADevice, error) {
+	input := &iam.ListVirtualMFADevicesInput{
+		AssignmentStatus: aws.String(iam.AssignmentStatusTypeAny),
+	}
+
+	var output *iam.VirtualMFADevice
+
+	err := conn.ListVirtualMFADevicesPages(input, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, device := range page.VirtualMFADevices {
+			if device == nil {
+				continue
+			}
+
+			if aws.StringValue(device.SerialNumber) == serialNumber {
+				output = device
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindMFADeviceTags returns the tags of the MFA device with the specified serial number.
+func FindMFADeviceTags(conn *iam.IAM, serialNumber string) ([]*iam.Tag, error) {
+	input := &iam.ListMFADeviceTagsInput{
+		SerialNumber: aws.String(serialNumber),
+	}
+
+	var output []*iam.Tag
+
+	for {
+		page, err := conn.ListMFADeviceTags(input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		output = append(output, page.Tags...)
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+
+		input.Marker = page.Marker
+	}
+
+	return output, nil
+}
+
+// FindSigningCertificateByUserNameAndID returns the signing certificate with the specified ID belonging to the specified user.
+func FindSigningCertificateByUserNameAndID(conn *iam.IAM, userName, certificateID string) (*iam.SigningCertificate, error) {
+	input := &iam.ListSigningCertificatesInput{
+		UserName: aws.String(userName),
+	}
+
+	var output *iam.SigningCertificate
+
+	err := conn.ListSigningCertificatesPages(input, func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, certificate := range page.Certificates {
+			if certificate == nil {
+				continue
+			}
+
+			if aws.StringValue(certificate.CertificateId) == certificateID {
+				output = certificate
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindServiceSpecificCredentialByUserNameServiceNameAndID returns the service-specific credential with the specified ID
+// belonging to the specified user and service.
+func FindServiceSpecificCredentialByUserNameServiceNameAndID(conn *iam.IAM, userName, serviceName, credentialID string) (*iam.ServiceSpecificCredentialMetadata, error) {
+	input := &iam.ListServiceSpecificCredentialsInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	}
+
+	output, err := conn.ListServiceSpecificCredentials(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, credential := range output.ServiceSpecificCredentials {
+		if credential == nil {
+			continue
+		}
+
+		if aws.StringValue(credential.ServiceSpecificCredentialId) == credentialID {
+			return credential, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// FindPolicyByARN returns the managed policy with the specified ARN.
+func FindPolicyByARN(conn *iam.IAM, arn string) (*iam.Policy, error) {
+	input := &iam.GetPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
+
+// FindPolicyVersionDocument returns the URL-decoded document of the specified managed policy version.
+func FindPolicyVersionDocument(conn *iam.IAM, arn, versionID string) (string, error) {
+	input := &iam.GetPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetPolicyVersion(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.PolicyVersion == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	document, err := url.QueryUnescape(aws.StringValue(output.PolicyVersion.Document))
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding IAM Policy (%s) version (%s) document: %w", arn, versionID, err)
+	}
+
+	return document, nil
+}
+
+// FindGroupPolicyDocument returns the URL-decoded document of the specified inline group policy.
+func FindGroupPolicyDocument(conn *iam.IAM, groupName, policyName string) (string, error) {
+	input := &iam.GetGroupPolicyInput{
+		GroupName:  aws.String(groupName),
+		PolicyName: aws.String(policyName),
+	}
+
+	output, err := conn.GetGroupPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return url.QueryUnescape(aws.StringValue(output.PolicyDocument))
+}
+
+// FindRolePolicyDocument returns the URL-decoded document of the specified inline role policy.
+func FindRolePolicyDocument(conn *iam.IAM, roleName, policyName string) (string, error) {
+	input := &iam.GetRolePolicyInput{
+		PolicyName: aws.String(policyName),
+		RoleName:   aws.String(roleName),
+	}
+
+	output, err := conn.GetRolePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return url.QueryUnescape(aws.StringValue(output.PolicyDocument))
+}
+
+// FindUserPolicyDocument returns the URL-decoded document of the specified inline user policy.
+func FindUserPolicyDocument(conn *iam.IAM, userName, policyName string) (string, error) {
+	input := &iam.GetUserPolicyInput{
+		PolicyName: aws.String(policyName),
+		UserName:   aws.String(userName),
+	}
+
+	output, err := conn.GetUserPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return url.QueryUnescape(aws.StringValue(output.PolicyDocument))
+}
+
+// FindGroupsForUser returns the groups that the specified user belongs to.
+func FindGroupsForUser(conn *iam.IAM, userName string) ([]*iam.Group, error) {
+	input := &iam.ListGroupsForUserInput{
+		UserName: aws.String(userName),
+	}
+
+	var output []*iam.Group
+
+	err := conn.ListGroupsForUserPages(input, func(page *iam.ListGroupsForUserOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.Groups {
+			if group == nil {
+				continue
+			}
+
+			output = append(output, group)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindUserByName returns the user with the specified name.
+func FindUserByName(conn *iam.IAM, name string) (*iam.User, error) {
+	input := &iam.GetUserInput{
+		UserName: aws.String(name),
+	}
+
+	output, err := conn.GetUser(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.User == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.User, nil
+}
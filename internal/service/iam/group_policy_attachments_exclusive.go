@@ -0,0 +1,131 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupPolicyAttachmentsExclusivePut,
+		Read:   resourceGroupPolicyAttachmentsExclusiveRead,
+		Update: resourceGroupPolicyAttachmentsExclusivePut,
+		Delete: resourceGroupPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	groupName := d.Get("group_name").(string)
+
+	actual, err := FindGroupAttachedPolicyARNs(conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s) policy attachments: %w", groupName, err)
+	}
+
+	add, remove := exclusiveDiff(d.Get("policy_arns").(*schema.Set), actual)
+
+	for _, policyARN := range add {
+		log.Printf("[DEBUG] Attaching IAM Policy (%s) to IAM Group (%s)", policyARN, groupName)
+		_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			GroupName: aws.String(groupName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error attaching IAM Policy (%s) to IAM Group (%s): %w", policyARN, groupName, err)
+		}
+	}
+
+	for _, policyARN := range remove {
+		log.Printf("[DEBUG] Detaching IAM Policy (%s) from IAM Group (%s)", policyARN, groupName)
+		_, err := conn.DetachGroupPolicy(&iam.DetachGroupPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			GroupName: aws.String(groupName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error detaching IAM Policy (%s) from IAM Group (%s): %w", policyARN, groupName, err)
+		}
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindGroupAttachedPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group (%s) not found, removing exclusive policy attachments from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s) policy attachments: %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() {
+		missing, unmanaged := exclusiveDiff(d.Get("policy_arns").(*schema.Set), policyARNs)
+
+		if len(unmanaged) > 0 {
+			log.Printf("[WARN] IAM Group (%s) has policies attached outside of this resource, they will be detached: %s", d.Id(), strings.Join(unmanaged, ", "))
+		}
+
+		if len(missing) > 0 {
+			log.Printf("[WARN] IAM Group (%s) has had policies detached outside of this resource, they will be reattached: %s", d.Id(), strings.Join(missing, ", "))
+		}
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the group's policy attachments.
+	// The attached policies are left in place as they may be managed elsewhere.
+	log.Printf("[DEBUG] Removing IAM Group (%s) exclusive policy attachments from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package iam
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"only_attached": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policy_usage_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(iam.PolicyUsageType_Values(), false),
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.PolicyScopeTypeAll,
+				ValidateFunc: validation.StringInSlice(iam.PolicyScopeType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourcePoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	input := &iam.ListPoliciesInput{
+		OnlyAttached: aws.Bool(d.Get("only_attached").(bool)),
+		Scope:        aws.String(d.Get("scope").(string)),
+	}
+
+	if v, ok := d.GetOk("path_prefix"); ok {
+		input.PathPrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_usage_filter"); ok {
+		input.PolicyUsageFilter = aws.String(v.(string))
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var results []*iam.Policy
+
+	err := conn.ListPoliciesPages(input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, policy := range page.Policies {
+			if policy == nil {
+				continue
+			}
+
+			if nameRegex != nil && !nameRegex.MatchString(aws.StringValue(policy.PolicyName)) {
+				continue
+			}
+
+			results = append(results, policy)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM policies: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	var arns, names []string
+
+	for _, r := range results {
+		arns = append(arns, aws.StringValue(r.Arn))
+		names = append(names, aws.StringValue(r.PolicyName))
+	}
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package iam_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPoliciesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "names.#", regexp.MustCompile("[^0].*$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPoliciesDataSource_nameRegex(t *testing.T) {
+	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_nameRegex(rCount, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", rCount),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", rCount),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPoliciesDataSource_pathPrefix(t *testing.T) {
+	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	dataSourceName := "data.aws_iam_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_pathPrefix(rCount, rName, rPathPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", rCount),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", rCount),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPoliciesDataSource_onlyAttached(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	dataSourceName := "data.aws_iam_policies.test"
+	policyResourceName := "aws_iam_policy.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_onlyAttached(rName, rPathPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", policyResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", policyResourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPoliciesDataSource_nonExistentPathPrefix(t *testing.T) {
+	dataSourceName := "data.aws_iam_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_nonExistentPathPrefix,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPoliciesDataSourceConfig_basic = `
+data "aws_iam_policies" "test" {
+  scope = "AWS"
+}
+`
+
+func testAccPoliciesDataSourceConfigBase(rCount, rName, rPathPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  count = %[1]s
+  name  = "%[2]s-${count.index}-policy"
+  path  = "/%[3]s/"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:DescribeInstances",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+
+  tags = {
+    Seed = %[2]q
+  }
+}
+`, rCount, rName, rPathPrefix)
+}
+
+func testAccPoliciesDataSourceConfig_nameRegex(rCount, rName string) string {
+	return acctest.ConfigCompose(testAccPoliciesDataSourceConfigBase(rCount, rName, "test"), `
+data "aws_iam_policies" "test" {
+  name_regex = "${aws_iam_policy.test[0].tags["Seed"]}-.*-policy"
+  scope      = "Local"
+}
+`)
+}
+
+func testAccPoliciesDataSourceConfig_pathPrefix(rCount, rName, rPathPrefix string) string {
+	return acctest.ConfigCompose(testAccPoliciesDataSourceConfigBase(rCount, rName, rPathPrefix), `
+data "aws_iam_policies" "test" {
+  path_prefix = aws_iam_policy.test[0].path
+  scope       = "Local"
+}
+`)
+}
+
+func testAccPoliciesDataSourceConfig_onlyAttached(rName, rPathPrefix string) string {
+	return acctest.ConfigCompose(testAccPoliciesDataSourceConfigBase("2", rName, rPathPrefix), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test[0].arn
+}
+
+data "aws_iam_policies" "test" {
+  only_attached = true
+  path_prefix   = aws_iam_policy.test[0].path
+  scope         = "Local"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+const testAccPoliciesDataSourceConfig_nonExistentPathPrefix = `
+data "aws_iam_policies" "test" {
+  path_prefix = "/dne/path/"
+  scope       = "Local"
+}
+`
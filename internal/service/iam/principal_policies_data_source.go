@@ -0,0 +1,396 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	principalTypeGroup = "group"
+	principalTypeRole  = "role"
+	principalTypeUser  = "user"
+)
+
+func DataSourcePrincipalPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePrincipalPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"inline_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"managed_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"permissions_boundary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	principalARN := d.Get("principal_arn").(string)
+	principalType, principalName, err := principalTypeAndNameFromARN(principalARN)
+
+	if err != nil {
+		return err
+	}
+
+	// Managed policy documents are cached as a policy can be attached both directly and via groups.
+	r := &principalPoliciesReader{
+		conn:     conn,
+		policies: make(map[string]map[string]interface{}),
+	}
+
+	var permissionsBoundaryARN string
+
+	switch principalType {
+	case principalTypeGroup:
+		if err := r.readGroup(principalName, principalARN); err != nil {
+			return err
+		}
+	case principalTypeRole:
+		role, err := FindRoleByName(conn, principalName)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM Role (%s): %w", principalName, err)
+		}
+
+		if role.PermissionsBoundary != nil {
+			permissionsBoundaryARN = aws.StringValue(role.PermissionsBoundary.PermissionsBoundaryArn)
+		}
+
+		if err := r.readRole(principalName, principalARN); err != nil {
+			return err
+		}
+	case principalTypeUser:
+		user, err := FindUserByName(conn, principalName)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM User (%s): %w", principalName, err)
+		}
+
+		if user.PermissionsBoundary != nil {
+			permissionsBoundaryARN = aws.StringValue(user.PermissionsBoundary.PermissionsBoundaryArn)
+		}
+
+		if err := r.readUser(principalName, principalARN); err != nil {
+			return err
+		}
+
+		groups, err := FindGroupsForUser(conn, principalName)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM User (%s) groups: %w", principalName, err)
+		}
+
+		for _, group := range groups {
+			if err := r.readGroup(aws.StringValue(group.GroupName), aws.StringValue(group.Arn)); err != nil {
+				return err
+			}
+		}
+	}
+
+	var permissionsBoundary []interface{}
+
+	if permissionsBoundaryARN != "" {
+		tfMap, err := r.managedPolicy(permissionsBoundaryARN)
+
+		if err != nil {
+			return err
+		}
+
+		permissionsBoundary = append(permissionsBoundary, tfMap)
+	}
+
+	d.SetId(principalARN)
+	if err := d.Set("inline_policies", r.inlinePolicies); err != nil {
+		return fmt.Errorf("error setting inline_policies: %w", err)
+	}
+	if err := d.Set("managed_policies", r.managedPolicies); err != nil {
+		return fmt.Errorf("error setting managed_policies: %w", err)
+	}
+	if err := d.Set("permissions_boundary", permissionsBoundary); err != nil {
+		return fmt.Errorf("error setting permissions_boundary: %w", err)
+	}
+
+	return nil
+}
+
+// principalPoliciesReader accumulates the managed and inline policies of one or more principals.
+type principalPoliciesReader struct {
+	conn            *iam.IAM
+	policies        map[string]map[string]interface{}
+	inlinePolicies  []interface{}
+	managedPolicies []interface{}
+}
+
+func (r *principalPoliciesReader) readGroup(name, sourceARN string) error {
+	policyARNs, err := FindGroupAttachedPolicyARNs(r.conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s) attached policies: %w", name, err)
+	}
+
+	if err := r.addManagedPolicies(policyARNs, sourceARN); err != nil {
+		return err
+	}
+
+	policyNames, err := FindGroupPolicyNames(r.conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s) inline policies: %w", name, err)
+	}
+
+	for _, policyName := range policyNames {
+		document, err := FindGroupPolicyDocument(r.conn, name, policyName)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM Group (%s) inline policy (%s): %w", name, policyName, err)
+		}
+
+		if err := r.addInlinePolicy(policyName, document, sourceARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *principalPoliciesReader) readRole(name, sourceARN string) error {
+	policyARNs, err := FindRoleAttachedPolicyARNs(r.conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) attached policies: %w", name, err)
+	}
+
+	if err := r.addManagedPolicies(policyARNs, sourceARN); err != nil {
+		return err
+	}
+
+	policyNames, err := FindRolePolicyNames(r.conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", name, err)
+	}
+
+	for _, policyName := range policyNames {
+		document, err := FindRolePolicyDocument(r.conn, name, policyName)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM Role (%s) inline policy (%s): %w", name, policyName, err)
+		}
+
+		if err := r.addInlinePolicy(policyName, document, sourceARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *principalPoliciesReader) readUser(name, sourceARN string) error {
+	policyARNs, err := FindUserAttachedPolicyARNs(r.conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM User (%s) attached policies: %w", name, err)
+	}
+
+	if err := r.addManagedPolicies(policyARNs, sourceARN); err != nil {
+		return err
+	}
+
+	policyNames, err := FindUserPolicyNames(r.conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM User (%s) inline policies: %w", name, err)
+	}
+
+	for _, policyName := range policyNames {
+		document, err := FindUserPolicyDocument(r.conn, name, policyName)
+
+		if err != nil {
+			return fmt.Errorf("error reading IAM User (%s) inline policy (%s): %w", name, policyName, err)
+		}
+
+		if err := r.addInlinePolicy(policyName, document, sourceARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *principalPoliciesReader) addInlinePolicy(name, document, sourceARN string) error {
+	policy, err := verify.PolicyToSet("", document)
+
+	if err != nil {
+		return err
+	}
+
+	r.inlinePolicies = append(r.inlinePolicies, map[string]interface{}{
+		"name":       name,
+		"policy":     policy,
+		"source_arn": sourceARN,
+	})
+
+	return nil
+}
+
+func (r *principalPoliciesReader) addManagedPolicies(policyARNs []string, sourceARN string) error {
+	for _, policyARN := range policyARNs {
+		policy, err := r.managedPolicy(policyARN)
+
+		if err != nil {
+			return err
+		}
+
+		tfMap := map[string]interface{}{
+			"source_arn": sourceARN,
+		}
+
+		for k, v := range policy {
+			tfMap[k] = v
+		}
+
+		r.managedPolicies = append(r.managedPolicies, tfMap)
+	}
+
+	return nil
+}
+
+// managedPolicy returns the normalized document of the default version of the specified managed policy.
+func (r *principalPoliciesReader) managedPolicy(policyARN string) (map[string]interface{}, error) {
+	if v, ok := r.policies[policyARN]; ok {
+		return v, nil
+	}
+
+	policy, err := FindPolicyByARN(r.conn, policyARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading IAM Policy (%s): %w", policyARN, err)
+	}
+
+	versionID := aws.StringValue(policy.DefaultVersionId)
+	document, err := FindPolicyVersionDocument(r.conn, policyARN, versionID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading IAM Policy (%s) version (%s): %w", policyARN, versionID, err)
+	}
+
+	document, err = verify.PolicyToSet("", document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":        policyARN,
+		"name":       aws.StringValue(policy.PolicyName),
+		"policy":     document,
+		"version_id": versionID,
+	}
+
+	r.policies[policyARN] = tfMap
+
+	return tfMap, nil
+}
+
+// principalTypeAndNameFromARN returns the type (group, role or user) and name of an IAM principal from its ARN,
+// e.g. arn:aws:iam::123456789012:role/division/example returns "role" and "example".
+func principalTypeAndNameFromARN(principalARN string) (string, string, error) {
+	parsedARN, err := arn.Parse(principalARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing IAM principal ARN (%s): %w", principalARN, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if parsedARN.Service == "iam" && len(parts) >= 2 {
+		switch principalType := parts[0]; principalType {
+		case principalTypeGroup, principalTypeRole, principalTypeUser:
+			return principalType, parts[len(parts)-1], nil
+		}
+	}
+
+	return "", "", fmt.Errorf("expected IAM group, role or user ARN (arn:PARTITION:iam::ACCOUNTID:TYPE/PATH/NAME), received: %s", principalARN)
+}
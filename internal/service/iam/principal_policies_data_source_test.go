@@ -0,0 +1,213 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPoliciesDataSource_role(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_policies.test"
+	roleResourceName := "aws_iam_role.test"
+	policyResourceName := "aws_iam_policy.test"
+	boundaryResourceName := "aws_iam_policy.boundary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPoliciesDataSourceConfig_role(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "inline_policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "inline_policies.0.name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "inline_policies.0.policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "inline_policies.0.source_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "managed_policies.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "managed_policies.0.arn", policyResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "managed_policies.0.name", policyResourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "managed_policies.0.policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "managed_policies.0.source_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "managed_policies.0.version_id", "v1"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions_boundary.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "permissions_boundary.0.arn", boundaryResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "permissions_boundary.0.policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPoliciesDataSource_user(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_policies.test"
+	userResourceName := "aws_iam_user.test"
+	groupResourceName := "aws_iam_group.test"
+	policyResourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPoliciesDataSourceConfig_user(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", userResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "inline_policies.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "inline_policies.*.source_arn", userResourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "inline_policies.*.source_arn", groupResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "managed_policies.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "managed_policies.0.arn", policyResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "managed_policies.0.source_arn", groupResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions_boundary.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPoliciesDataSourceConfigPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:DescribeInstances"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPrincipalPoliciesDataSourceConfig_role(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPoliciesDataSourceConfigPolicy(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_policy" "boundary" {
+  name = "%[1]s-boundary"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_role" "test" {
+  name                 = %[1]q
+  permissions_boundary = aws_iam_policy.boundary.arn
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListAllMyBuckets"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test.arn
+}
+
+data "aws_iam_principal_policies" "test" {
+  principal_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccPrincipalPoliciesDataSourceConfig_user(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPoliciesDataSourceConfigPolicy(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListAllMyBuckets"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_group_policy" "test" {
+  name  = %[1]q
+  group = aws_iam_group.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sqs:ListQueues"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policy_attachment" "test" {
+  group      = aws_iam_group.test.name
+  policy_arn = aws_iam_policy.test.arn
+}
+
+resource "aws_iam_user_group_membership" "test" {
+  user   = aws_iam_user.test.name
+  groups = [aws_iam_group.test.name]
+}
+
+data "aws_iam_principal_policies" "test" {
+  principal_arn = aws_iam_user.test.arn
+
+  depends_on = [
+    aws_iam_group_policy.test,
+    aws_iam_group_policy_attachment.test,
+    aws_iam_user_group_membership.test,
+    aws_iam_user_policy.test,
+  ]
+}
+`, rName))
+}
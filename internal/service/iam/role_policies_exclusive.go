@@ -0,0 +1,120 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePoliciesExclusivePut,
+		Read:   resourceRolePoliciesExclusiveRead,
+		Update: resourceRolePoliciesExclusivePut,
+		Delete: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+
+	actual, err := FindRolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	add, remove := exclusiveDiff(d.Get("policy_names").(*schema.Set), actual)
+
+	// Inline policy documents are managed by the aws_iam_role_policy resource, so missing policies cannot be created here.
+	if len(add) > 0 {
+		return fmt.Errorf("IAM Role (%s) inline policies (%s) not found, they must be created with the aws_iam_role_policy resource", roleName, strings.Join(add, ", "))
+	}
+
+	for _, policyName := range remove {
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy: %s", roleName, policyName)
+		_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(policyName),
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting IAM Role (%s) inline policy (%s): %w", roleName, policyName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindRolePolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing exclusive inline policies from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() {
+		missing, unmanaged := exclusiveDiff(d.Get("policy_names").(*schema.Set), policyNames)
+
+		if len(unmanaged) > 0 {
+			log.Printf("[WARN] IAM Role (%s) has inline policies created outside of this resource, they will be deleted: %s", d.Id(), strings.Join(unmanaged, ", "))
+		}
+
+		if len(missing) > 0 {
+			log.Printf("[WARN] IAM Role (%s) has had inline policies deleted outside of this resource: %s", d.Id(), strings.Join(missing, ", "))
+		}
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the role's inline policies.
+	// The inline policies are left in place as they are managed by the aws_iam_role_policy resource.
+	log.Printf("[DEBUG] Removing IAM Role (%s) exclusive inline policies from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceServiceSpecificCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceSpecificCredentialCreate,
+		Read:   resourceServiceSpecificCredentialRead,
+		Update: resourceServiceSpecificCredentialUpdate,
+		Delete: resourceServiceSpecificCredentialDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"service_specific_credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice(iam.StatusType_Values(), false),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceServiceSpecificCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+	input := &iam.CreateServiceSpecificCredentialInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Service Specific Credential: %s", input)
+	output, err := conn.CreateServiceSpecificCredential(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Service Specific Credential for IAM User (%s) and service (%s): %w", userName, serviceName, err)
+	}
+
+	credential := output.ServiceSpecificCredential
+	d.SetId(ServiceSpecificCredentialCreateResourceID(serviceName, userName, aws.StringValue(credential.ServiceSpecificCredentialId)))
+
+	// The password is only returned when the credential is created.
+	d.Set("service_password", credential.ServicePassword)
+
+	// Credentials are always created as active.
+	if v := d.Get("status").(string); v != iam.StatusTypeActive {
+		if err := updateServiceSpecificCredentialStatus(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	return resourceServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceServiceSpecificCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	serviceName, userName, credentialID, err := ServiceSpecificCredentialParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindServiceSpecificCredentialByUserNameServiceNameAndID(conn, userName, serviceName, credentialID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Service Specific Credential (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Service Specific Credential (%s): %w", d.Id(), err)
+	}
+
+	credential := outputRaw.(*iam.ServiceSpecificCredentialMetadata)
+
+	if credential.CreateDate != nil {
+		d.Set("create_date", aws.TimeValue(credential.CreateDate).Format(time.RFC3339))
+	} else {
+		d.Set("create_date", nil)
+	}
+	d.Set("service_name", credential.ServiceName)
+	d.Set("service_specific_credential_id", credential.ServiceSpecificCredentialId)
+	d.Set("service_user_name", credential.ServiceUserName)
+	d.Set("status", credential.Status)
+	d.Set("user_name", credential.UserName)
+
+	return nil
+}
+
+func resourceServiceSpecificCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("status") {
+		if err := updateServiceSpecificCredentialStatus(conn, d.Id(), d.Get("status").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceServiceSpecificCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	_, userName, credentialID, err := ServiceSpecificCredentialParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Service Specific Credential: %s", d.Id())
+	_, err = conn.DeleteServiceSpecificCredential(&iam.DeleteServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(credentialID),
+		UserName:                    aws.String(userName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Service Specific Credential (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateServiceSpecificCredentialStatus(conn *iam.IAM, id, status string) error {
+	_, userName, credentialID, err := ServiceSpecificCredentialParseResourceID(id)
+
+	if err != nil {
+		return err
+	}
+
+	input := &iam.UpdateServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(credentialID),
+		Status:                      aws.String(status),
+		UserName:                    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating IAM Service Specific Credential: %s", input)
+	if _, err := conn.UpdateServiceSpecificCredential(input); err != nil {
+		return fmt.Errorf("error updating IAM Service Specific Credential (%s) status: %w", id, err)
+	}
+
+	return nil
+}
+
+const serviceSpecificCredentialResourceIDSeparator = ":"
+
+func ServiceSpecificCredentialCreateResourceID(serviceName, userName, credentialID string) string {
+	parts := []string{serviceName, userName, credentialID}
+	id := strings.Join(parts, serviceSpecificCredentialResourceIDSeparator)
+
+	return id
+}
+
+func ServiceSpecificCredentialParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, serviceSpecificCredentialResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVICE-NAME%[2]sUSER-NAME%[2]sSERVICE-SPECIFIC-CREDENTIAL-ID", id, serviceSpecificCredentialResourceIDSeparator)
+}
@@ -0,0 +1,177 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIAMServiceSpecificCredential_basic(t *testing.T) {
+	var cred iam.ServiceSpecificCredentialMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSpecificCredentialConfig(rName, "codecommit.amazonaws.com", iam.StatusTypeActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(resourceName, &cred),
+					resource.TestCheckResourceAttrSet(resourceName, "create_date"),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "codecommit.amazonaws.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_password"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_specific_credential_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_user_name"),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeActive),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_password"},
+			},
+			{
+				Config: testAccServiceSpecificCredentialConfig(rName, "codecommit.amazonaws.com", iam.StatusTypeInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(resourceName, &cred),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceSpecificCredential_keyspaces(t *testing.T) {
+	var cred iam.ServiceSpecificCredentialMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSpecificCredentialConfig(rName, "cassandra.amazonaws.com", iam.StatusTypeInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(resourceName, &cred),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "cassandra.amazonaws.com"),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceSpecificCredential_disappears(t *testing.T) {
+	var cred iam.ServiceSpecificCredentialMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSpecificCredentialConfig(rName, "codecommit.amazonaws.com", iam.StatusTypeActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceSpecificCredentialExists(resourceName, &cred),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiam.ResourceServiceSpecificCredential(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceSpecificCredentialDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_service_specific_credential" {
+			continue
+		}
+
+		serviceName, userName, credentialID, err := tfiam.ServiceSpecificCredentialParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfiam.FindServiceSpecificCredentialByUserNameServiceNameAndID(conn, userName, serviceName, credentialID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IAM Service Specific Credential %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckServiceSpecificCredentialExists(n string, v *iam.ServiceSpecificCredentialMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Service Specific Credential ID is set")
+		}
+
+		serviceName, userName, credentialID, err := tfiam.ServiceSpecificCredentialParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		output, err := tfiam.FindServiceSpecificCredentialByUserNameServiceNameAndID(conn, userName, serviceName, credentialID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceSpecificCredentialConfig(rName, serviceName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = %[2]q
+  status       = %[3]q
+  user_name    = aws_iam_user.test.name
+}
+`, rName, serviceName, status)
+}
@@ -0,0 +1,203 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSigningCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSigningCertificateCreate,
+		Read:   resourceSigningCertificateRead,
+		Update: resourceSigningCertificateUpdate,
+		Delete: resourceSigningCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_body": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return string(stripCR([]byte(strings.TrimSpace(old)))) == string(stripCR([]byte(strings.TrimSpace(new))))
+				},
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice(iam.StatusType_Values(), false),
+			},
+			"upload_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSigningCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+	input := &iam.UploadSigningCertificateInput{
+		CertificateBody: aws.String(d.Get("certificate_body").(string)),
+		UserName:        aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Signing Certificate: %s", input)
+	output, err := conn.UploadSigningCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Signing Certificate for IAM User (%s): %w", userName, err)
+	}
+
+	d.SetId(SigningCertificateCreateResourceID(aws.StringValue(output.Certificate.CertificateId), userName))
+
+	// Certificates are always uploaded as active.
+	if v := d.Get("status").(string); v != iam.StatusTypeActive {
+		if err := updateSigningCertificateStatus(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	return resourceSigningCertificateRead(d, meta)
+}
+
+func resourceSigningCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	certificateID, userName, err := SigningCertificateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindSigningCertificateByUserNameAndID(conn, userName, certificateID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Signing Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	certificate := outputRaw.(*iam.SigningCertificate)
+
+	d.Set("certificate_body", certificate.CertificateBody)
+	d.Set("certificate_id", certificate.CertificateId)
+	d.Set("status", certificate.Status)
+	if certificate.UploadDate != nil {
+		d.Set("upload_date", aws.TimeValue(certificate.UploadDate).Format(time.RFC3339))
+	} else {
+		d.Set("upload_date", nil)
+	}
+	d.Set("user_name", certificate.UserName)
+
+	return nil
+}
+
+func resourceSigningCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("status") {
+		if err := updateSigningCertificateStatus(conn, d.Id(), d.Get("status").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSigningCertificateRead(d, meta)
+}
+
+func resourceSigningCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	certificateID, userName, err := SigningCertificateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Signing Certificate: %s", d.Id())
+	_, err = conn.DeleteSigningCertificate(&iam.DeleteSigningCertificateInput{
+		CertificateId: aws.String(certificateID),
+		UserName:      aws.String(userName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateSigningCertificateStatus(conn *iam.IAM, id, status string) error {
+	certificateID, userName, err := SigningCertificateParseResourceID(id)
+
+	if err != nil {
+		return err
+	}
+
+	input := &iam.UpdateSigningCertificateInput{
+		CertificateId: aws.String(certificateID),
+		Status:        aws.String(status),
+		UserName:      aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating IAM Signing Certificate: %s", input)
+	if _, err := conn.UpdateSigningCertificate(input); err != nil {
+		return fmt.Errorf("error updating IAM Signing Certificate (%s) status: %w", id, err)
+	}
+
+	return nil
+}
+
+const signingCertificateResourceIDSeparator = ":"
+
+func SigningCertificateCreateResourceID(certificateID, userName string) string {
+	parts := []string{certificateID, userName}
+	id := strings.Join(parts, signingCertificateResourceIDSeparator)
+
+	return id
+}
+
+func SigningCertificateParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, signingCertificateResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CERTIFICATE-ID%[2]sUSER-NAME", id, signingCertificateResourceIDSeparator)
+}
@@ -0,0 +1,178 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIAMSigningCertificate_basic(t *testing.T) {
+	var cert iam.SigningCertificate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_signing_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningCertificateConfig(rName, certificate, iam.StatusTypeActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningCertificateExists(resourceName, &cert),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeActive),
+					resource.TestCheckResourceAttrSet(resourceName, "upload_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSigningCertificateConfig(rName, certificate, iam.StatusTypeInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningCertificateExists(resourceName, &cert),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMSigningCertificate_inactive(t *testing.T) {
+	var cert iam.SigningCertificate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_signing_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningCertificateConfig(rName, certificate, iam.StatusTypeInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningCertificateExists(resourceName, &cert),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMSigningCertificate_disappears(t *testing.T) {
+	var cert iam.SigningCertificate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_signing_certificate.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningCertificateConfig(rName, certificate, iam.StatusTypeActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningCertificateExists(resourceName, &cert),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiam.ResourceSigningCertificate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSigningCertificateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_signing_certificate" {
+			continue
+		}
+
+		certificateID, userName, err := tfiam.SigningCertificateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfiam.FindSigningCertificateByUserNameAndID(conn, userName, certificateID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IAM Signing Certificate %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSigningCertificateExists(n string, v *iam.SigningCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Signing Certificate ID is set")
+		}
+
+		certificateID, userName, err := tfiam.SigningCertificateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		output, err := tfiam.FindSigningCertificateByUserNameAndID(conn, userName, certificateID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSigningCertificateConfig(rName, certificate, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_signing_certificate" "test" {
+  certificate_body = "%[2]s"
+  status           = %[3]q
+  user_name        = aws_iam_user.test.name
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), status)
+}
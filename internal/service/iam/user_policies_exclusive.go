@@ -0,0 +1,120 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserPoliciesExclusivePut,
+		Read:   resourceUserPoliciesExclusiveRead,
+		Update: resourceUserPoliciesExclusivePut,
+		Delete: resourceUserPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+
+	actual, err := FindUserPolicyNames(conn, userName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM User (%s) inline policies: %w", userName, err)
+	}
+
+	add, remove := exclusiveDiff(d.Get("policy_names").(*schema.Set), actual)
+
+	// Inline policy documents are managed by the aws_iam_user_policy resource, so missing policies cannot be created here.
+	if len(add) > 0 {
+		return fmt.Errorf("IAM User (%s) inline policies (%s) not found, they must be created with the aws_iam_user_policy resource", userName, strings.Join(add, ", "))
+	}
+
+	for _, policyName := range remove {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy: %s", userName, policyName)
+		_, err := conn.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName),
+			UserName:   aws.String(userName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting IAM User (%s) inline policy (%s): %w", userName, policyName, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPoliciesExclusiveRead(d, meta)
+}
+
+func resourceUserPoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindUserPolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User (%s) not found, removing exclusive inline policies from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM User (%s) inline policies: %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() {
+		missing, unmanaged := exclusiveDiff(d.Get("policy_names").(*schema.Set), policyNames)
+
+		if len(unmanaged) > 0 {
+			log.Printf("[WARN] IAM User (%s) has inline policies created outside of this resource, they will be deleted: %s", d.Id(), strings.Join(unmanaged, ", "))
+		}
+
+		if len(missing) > 0 {
+			log.Printf("[WARN] IAM User (%s) has had inline policies deleted outside of this resource: %s", d.Id(), strings.Join(missing, ", "))
+		}
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the user's inline policies.
+	// The inline policies are left in place as they are managed by the aws_iam_user_policy resource.
+	log.Printf("[DEBUG] Removing IAM User (%s) exclusive inline policies from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserPolicyAttachmentsExclusivePut,
+		Read:   resourceUserPolicyAttachmentsExclusiveRead,
+		Update: resourceUserPolicyAttachmentsExclusivePut,
+		Delete: resourceUserPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+
+	actual, err := FindUserAttachedPolicyARNs(conn, userName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM User (%s) policy attachments: %w", userName, err)
+	}
+
+	add, remove := exclusiveDiff(d.Get("policy_arns").(*schema.Set), actual)
+
+	for _, policyARN := range add {
+		log.Printf("[DEBUG] Attaching IAM Policy (%s) to IAM User (%s)", policyARN, userName)
+		_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			UserName:  aws.String(userName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error attaching IAM Policy (%s) to IAM User (%s): %w", policyARN, userName, err)
+		}
+	}
+
+	for _, policyARN := range remove {
+		log.Printf("[DEBUG] Detaching IAM Policy (%s) from IAM User (%s)", policyARN, userName)
+		_, err := conn.DetachUserPolicy(&iam.DetachUserPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			UserName:  aws.String(userName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error detaching IAM Policy (%s) from IAM User (%s): %w", policyARN, userName, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindUserAttachedPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User (%s) not found, removing exclusive policy attachments from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM User (%s) policy attachments: %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() {
+		missing, unmanaged := exclusiveDiff(d.Get("policy_arns").(*schema.Set), policyARNs)
+
+		if len(unmanaged) > 0 {
+			log.Printf("[WARN] IAM User (%s) has policies attached outside of this resource, they will be detached: %s", d.Id(), strings.Join(unmanaged, ", "))
+		}
+
+		if len(missing) > 0 {
+			log.Printf("[WARN] IAM User (%s) has had policies detached outside of this resource, they will be reattached: %s", d.Id(), strings.Join(missing, ", "))
+		}
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the user's policy attachments.
+	// The attached policies are left in place as they may be managed elsewhere.
+	log.Printf("[DEBUG] Removing IAM User (%s) exclusive policy attachments from state", d.Id())
+
+	return nil
+}
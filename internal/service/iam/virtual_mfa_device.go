@@ -0,0 +1,244 @@
+package iam
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceVirtualMFADevice has no status argument: unlike signing certificates and service specific credentials,
+// a virtual MFA device is not activated or deactivated on its own but is enabled for, and deactivated from,
+// an IAM user with EnableMFADevice and DeactivateMFADevice, which require authentication codes from the seed.
+func ResourceVirtualMFADevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualMFADeviceCreate,
+		Read:   resourceVirtualMFADeviceRead,
+		Update: resourceVirtualMFADeviceUpdate,
+		Delete: resourceVirtualMFADeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_32_string_seed": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"enable_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "/",
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 512),
+					validation.StringMatch(regexp.MustCompile(`^/(.*/)?$`), "must begin and end with a forward slash (/)"),
+				),
+			},
+			"qr_code_png": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtual_mfa_device_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 226),
+					validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]+$`), "must only contain alphanumeric characters or any of the following: _+=,.@-"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVirtualMFADeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("virtual_mfa_device_name").(string)
+	input := &iam.CreateVirtualMFADeviceInput{
+		Path:                 aws.String(d.Get("path").(string)),
+		VirtualMFADeviceName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IAM Virtual MFA Device: %s", input)
+	output, err := conn.CreateVirtualMFADevice(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Virtual MFA Device (%s): %w", name, err)
+	}
+
+	device := output.VirtualMFADevice
+	d.SetId(aws.StringValue(device.SerialNumber))
+
+	// The seed and QR code are only returned when the device is created.
+	d.Set("base_32_string_seed", string(device.Base32StringSeed))
+	d.Set("qr_code_png", base64.StdEncoding.EncodeToString(device.QRCodePNG))
+
+	return resourceVirtualMFADeviceRead(d, meta)
+}
+
+func resourceVirtualMFADeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindVirtualMFADeviceBySerialNumber(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Virtual MFA Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Virtual MFA Device (%s): %w", d.Id(), err)
+	}
+
+	device := outputRaw.(*iam.VirtualMFADevice)
+
+	path, name, err := DecodeVirtualMFADeviceSerialNumber(aws.StringValue(device.SerialNumber))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", device.SerialNumber)
+	if device.EnableDate != nil {
+		d.Set("enable_date", aws.TimeValue(device.EnableDate).Format(time.RFC3339))
+	} else {
+		d.Set("enable_date", nil)
+	}
+	d.Set("path", path)
+	if device.User != nil {
+		d.Set("user_name", device.User.UserName)
+	} else {
+		d.Set("user_name", nil)
+	}
+	d.Set("virtual_mfa_device_name", name)
+
+	tags, err := FindMFADeviceTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IAM Virtual MFA Device (%s): %w", d.Id(), err)
+	}
+
+	tagsMap := KeyValueTags(tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tagsMap.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tagsMap.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVirtualMFADeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := virtualMFADeviceUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags for IAM Virtual MFA Device (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceVirtualMFADeviceRead(d, meta)
+}
+
+func resourceVirtualMFADeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	// A device that has been enabled for a user must be deactivated before it can be deleted.
+	if v, ok := d.GetOk("user_name"); ok {
+		log.Printf("[DEBUG] Deactivating IAM Virtual MFA Device: %s", d.Id())
+		_, err := conn.DeactivateMFADevice(&iam.DeactivateMFADeviceInput{
+			SerialNumber: aws.String(d.Id()),
+			UserName:     aws.String(v.(string)),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return fmt.Errorf("error deactivating IAM Virtual MFA Device (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Virtual MFA Device: %s", d.Id())
+	_, err := conn.DeleteVirtualMFADevice(&iam.DeleteVirtualMFADeviceInput{
+		SerialNumber: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Virtual MFA Device (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// DecodeVirtualMFADeviceSerialNumber returns the path and name of a virtual MFA device from its serial number (ARN),
+// e.g. arn:aws:iam::123456789012:mfa/division/example returns "/division/" and "example".
+func DecodeVirtualMFADeviceSerialNumber(serialNumber string) (string, string, error) {
+	parsedARN, err := arn.Parse(serialNumber)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing IAM Virtual MFA Device serial number (%s): %w", serialNumber, err)
+	}
+
+	resourcePath := strings.TrimPrefix(parsedARN.Resource, "mfa/")
+
+	if resourcePath == parsedARN.Resource || resourcePath == "" {
+		return "", "", fmt.Errorf("expected IAM Virtual MFA Device serial number (arn:PARTITION:iam::ACCOUNTID:mfa/PATH/NAME), received: %s", serialNumber)
+	}
+
+	i := strings.LastIndex(resourcePath, "/")
+
+	return "/" + resourcePath[:i+1], resourcePath[i+1:], nil
+}
@@ -0,0 +1,283 @@
+package iam_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestDecodeVirtualMFADeviceSerialNumber(t *testing.T) {
+	testCases := []struct {
+		Input        string
+		ExpectedPath string
+		ExpectedName string
+		ErrCount     int
+	}{
+		{
+			Input:    "not-an-arn",
+			ErrCount: 1,
+		},
+		{
+			Input:    "arn:aws:iam::123456789012:user/example",
+			ErrCount: 1,
+		},
+		{
+			Input:        "arn:aws:iam::123456789012:mfa/example",
+			ExpectedPath: "/",
+			ExpectedName: "example",
+		},
+		{
+			Input:        "arn:aws:iam::123456789012:mfa/division/team/example",
+			ExpectedPath: "/division/team/",
+			ExpectedName: "example",
+		},
+	}
+
+	for _, tc := range testCases {
+		path, name, err := tfiam.DecodeVirtualMFADeviceSerialNumber(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if path != tc.ExpectedPath {
+			t.Fatalf("expected %q to return path %q, received: %q", tc.Input, tc.ExpectedPath, path)
+		}
+		if name != tc.ExpectedName {
+			t.Fatalf("expected %q to return name %q, received: %q", tc.Input, tc.ExpectedName, name)
+		}
+	}
+}
+
+func TestAccIAMVirtualMFADevice_basic(t *testing.T) {
+	var device iam.VirtualMFADevice
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualMFADeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualMFADeviceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualMFADeviceExists(resourceName, &device),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "arn", "iam", fmt.Sprintf("mfa/%s", rName)),
+					resource.TestMatchResourceAttr(resourceName, "base_32_string_seed", regexp.MustCompile(`^[A-Z2-7]+=*$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "qr_code_png"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "virtual_mfa_device_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"base_32_string_seed", "qr_code_png"},
+			},
+		},
+	})
+}
+
+func TestAccIAMVirtualMFADevice_path(t *testing.T) {
+	var device iam.VirtualMFADevice
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualMFADeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualMFADevicePathConfig(rName, "/division/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualMFADeviceExists(resourceName, &device),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "arn", "iam", fmt.Sprintf("mfa/division/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "path", "/division/"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"base_32_string_seed", "qr_code_png"},
+			},
+		},
+	})
+}
+
+func TestAccIAMVirtualMFADevice_tags(t *testing.T) {
+	var device iam.VirtualMFADevice
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualMFADeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualMFADeviceTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualMFADeviceExists(resourceName, &device),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"base_32_string_seed", "qr_code_png"},
+			},
+			{
+				Config: testAccVirtualMFADeviceTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualMFADeviceExists(resourceName, &device),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVirtualMFADeviceTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualMFADeviceExists(resourceName, &device),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMVirtualMFADevice_disappears(t *testing.T) {
+	var device iam.VirtualMFADevice
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualMFADeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualMFADeviceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualMFADeviceExists(resourceName, &device),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiam.ResourceVirtualMFADevice(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualMFADeviceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_virtual_mfa_device" {
+			continue
+		}
+
+		_, err := tfiam.FindVirtualMFADeviceBySerialNumber(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IAM Virtual MFA Device %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVirtualMFADeviceExists(n string, v *iam.VirtualMFADevice) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Virtual MFA Device ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		output, err := tfiam.FindVirtualMFADeviceBySerialNumber(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVirtualMFADeviceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+}
+`, rName)
+}
+
+func testAccVirtualMFADevicePathConfig(rName, path string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+  path                    = %[2]q
+}
+`, rName, path)
+}
+
+func testAccVirtualMFADeviceTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccVirtualMFADeviceTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  virtual_mfa_device_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
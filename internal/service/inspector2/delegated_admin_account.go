@@ -0,0 +1,107 @@
+package inspector2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDelegatedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDelegatedAdminAccountCreate,
+		Read:   resourceDelegatedAdminAccountRead,
+		Delete: resourceDelegatedAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDelegatedAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountID := d.Get("account_id").(string)
+
+	input := &inspector2.EnableDelegatedAdminAccountInput{
+		DelegatedAdminAccountId: aws.String(accountID),
+	}
+
+	_, err := conn.EnableDelegatedAdminAccount(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Inspector2 Delegated Admin Account (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitDelegatedAdminAccountEnabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Delegated Admin Account (%s) to enable: %w", d.Id(), err)
+	}
+
+	return resourceDelegatedAdminAccountRead(d, meta)
+}
+
+func resourceDelegatedAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	output, err := FindDelegatedAdminAccountByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Delegated Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Delegated Admin Account (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", output.AccountId)
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceDelegatedAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[DEBUG] Disabling Inspector2 Delegated Admin Account: %s", d.Id())
+	_, err := conn.DisableDelegatedAdminAccount(&inspector2.DisableDelegatedAdminAccountInput{
+		DelegatedAdminAccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Inspector2 Delegated Admin Account (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDelegatedAdminAccountDisabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Delegated Admin Account (%s) to disable: %w", d.Id(), err)
+	}
+
+	return nil
+}
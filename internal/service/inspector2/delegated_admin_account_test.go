@@ -0,0 +1,122 @@
+package inspector2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDelegatedAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegatedAdminAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegatedAdminAccountExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", inspector2.DelegatedAdminStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDelegatedAdminAccount_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegatedAdminAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegatedAdminAccountExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceDelegatedAdminAccount(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDelegatedAdminAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 Delegated Admin Account ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		_, err := tfinspector2.FindDelegatedAdminAccountByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDelegatedAdminAccountDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_delegated_admin_account" {
+			continue
+		}
+
+		_, err := tfinspector2.FindDelegatedAdminAccountByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Inspector2 Delegated Admin Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDelegatedAdminAccountConfig() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+`
+}
@@ -0,0 +1,238 @@
+package inspector2
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnabler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnablerCreate,
+		Read:   resourceEnablerRead,
+		Delete: resourceEnablerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inspector2.ResourceScanType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceEnablerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+	resourceTypes := aws.StringValueSlice(flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)))
+	id := EnablerCreateResourceID(accountIDs, resourceTypes)
+
+	input := &inspector2.EnableInput{
+		AccountIds:    aws.StringSlice(accountIDs),
+		ResourceTypes: aws.StringSlice(resourceTypes),
+	}
+
+	log.Printf("[DEBUG] Enabling Inspector2: %s", input)
+	output, err := conn.Enable(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Inspector2 (%s): %w", id, err)
+	}
+
+	if err := failedAccountsError(output.FailedAccounts); err != nil {
+		return fmt.Errorf("error enabling Inspector2 (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	for _, accountID := range accountIDs {
+		for _, resourceType := range resourceTypes {
+			if _, err := waitEnabled(conn, accountID, resourceType, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf("error waiting for Inspector2 (%s) %s scanning to enable: %w", accountID, resourceType, err)
+			}
+		}
+	}
+
+	return resourceEnablerRead(d, meta)
+}
+
+func resourceEnablerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs, resourceTypes, err := EnablerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// A resource type is only considered enabled if it is enabled in every account.
+	enabledResourceTypes := make(map[string]int)
+
+	for _, accountID := range accountIDs {
+		output, err := FindAccountStatusByID(conn, accountID)
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] Inspector2 Enabler (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Inspector2 account (%s) status: %w", accountID, err)
+		}
+
+		for _, resourceType := range resourceTypes {
+			if state := resourceStateByType(output.ResourceState, resourceType); state != nil && aws.StringValue(state.Status) == inspector2.StatusEnabled {
+				enabledResourceTypes[resourceType]++
+			}
+		}
+	}
+
+	var enabled []string
+
+	for _, resourceType := range resourceTypes {
+		if enabledResourceTypes[resourceType] == len(accountIDs) {
+			enabled = append(enabled, resourceType)
+		}
+	}
+
+	if !d.IsNewResource() && len(enabled) == 0 {
+		log.Printf("[WARN] Inspector2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("resource_types", enabled)
+
+	return nil
+}
+
+func resourceEnablerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs, resourceTypes, err := EnablerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Disabling Inspector2: %s", d.Id())
+	output, err := conn.Disable(&inspector2.DisableInput{
+		AccountIds:    aws.StringSlice(accountIDs),
+		ResourceTypes: aws.StringSlice(resourceTypes),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disabling Inspector2 (%s): %w", d.Id(), err)
+	}
+
+	if err := failedAccountsError(output.FailedAccounts); err != nil {
+		return fmt.Errorf("error disabling Inspector2 (%s): %w", d.Id(), err)
+	}
+
+	for _, accountID := range accountIDs {
+		for _, resourceType := range resourceTypes {
+			if _, err := waitDisabled(conn, accountID, resourceType, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return fmt.Errorf("error waiting for Inspector2 (%s) %s scanning to disable: %w", accountID, resourceType, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+const (
+	enablerResourceIDSeparator     = "-"
+	enablerResourceIDListSeparator = ":"
+)
+
+func EnablerCreateResourceID(accountIDs, resourceTypes []string) string {
+	accountIDs = append([]string(nil), accountIDs...)
+	resourceTypes = append([]string(nil), resourceTypes...)
+	sort.Strings(accountIDs)
+	sort.Strings(resourceTypes)
+
+	parts := []string{strings.Join(accountIDs, enablerResourceIDListSeparator), strings.Join(resourceTypes, enablerResourceIDListSeparator)}
+	id := strings.Join(parts, enablerResourceIDSeparator)
+
+	return id
+}
+
+func EnablerParseResourceID(id string) ([]string, []string, error) {
+	parts := strings.Split(id, enablerResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return strings.Split(parts[0], enablerResourceIDListSeparator), strings.Split(parts[1], enablerResourceIDListSeparator), nil
+	}
+
+	return nil, nil, fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccount-id%[3]sresource-type%[2]sresource-type", id, enablerResourceIDListSeparator, enablerResourceIDSeparator)
+}
+
+func failedAccountsError(apiObjects []*inspector2.FailedAccount) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("account (%s): %s: %s", aws.StringValue(apiObject.AccountId), aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func resourceStateByType(apiObject *inspector2.ResourceState, resourceType string) *inspector2.State {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch resourceType {
+	case inspector2.ResourceScanTypeEc2:
+		return apiObject.Ec2
+	case inspector2.ResourceScanTypeEcr:
+		return apiObject.Ecr
+	}
+
+	return nil
+}
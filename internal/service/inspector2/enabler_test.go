@@ -0,0 +1,265 @@
+package inspector2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestEnablerParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		InputID               string
+		ExpectError           bool
+		ExpectedAccountIDs    []string
+		ExpectedResourceTypes []string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing resource types",
+			InputID:     "123456789012-",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "123456789012-EC2-ECR",
+			ExpectError: true,
+		},
+		{
+			TestName:              "single account and resource type",
+			InputID:               "123456789012-EC2",
+			ExpectedAccountIDs:    []string{"123456789012"},
+			ExpectedResourceTypes: []string{"EC2"},
+		},
+		{
+			TestName:              "multiple accounts and resource types",
+			InputID:               "123456789012:210987654321-EC2:ECR",
+			ExpectedAccountIDs:    []string{"123456789012", "210987654321"},
+			ExpectedResourceTypes: []string{"EC2", "ECR"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAccountIDs, gotResourceTypes, err := tfinspector2.EnablerParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if fmt.Sprint(gotAccountIDs) != fmt.Sprint(testCase.ExpectedAccountIDs) {
+				t.Errorf("got account IDs %v, expected %v", gotAccountIDs, testCase.ExpectedAccountIDs)
+			}
+
+			if fmt.Sprint(gotResourceTypes) != fmt.Sprint(testCase.ExpectedResourceTypes) {
+				t.Errorf("got resource types %v, expected %v", gotResourceTypes, testCase.ExpectedResourceTypes)
+			}
+		})
+	}
+}
+
+func TestEnablerCreateResourceID(t *testing.T) {
+	got := tfinspector2.EnablerCreateResourceID([]string{"210987654321", "123456789012"}, []string{"ECR", "EC2"})
+	expected := "123456789012:210987654321-EC2:ECR"
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func testAccEnabler_basic(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig(`["EC2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "EC2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnabler_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig(`["EC2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceEnabler(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEnabler_resourceTypes(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, inspector2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig(`["EC2", "ECR"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "EC2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "ECR"),
+				),
+			},
+			{
+				Config: testAccEnablerConfig(`["ECR"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "ECR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnablerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 Enabler ID is set")
+		}
+
+		accountIDs, resourceTypes, err := tfinspector2.EnablerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		for _, accountID := range accountIDs {
+			output, err := tfinspector2.FindAccountStatusByID(conn, accountID)
+
+			if err != nil {
+				return err
+			}
+
+			for _, resourceType := range resourceTypes {
+				var state *inspector2.State
+
+				switch resourceType {
+				case inspector2.ResourceScanTypeEc2:
+					state = output.ResourceState.Ec2
+				case inspector2.ResourceScanTypeEcr:
+					state = output.ResourceState.Ecr
+				}
+
+				if status := aws.StringValue(state.Status); status != inspector2.StatusEnabled {
+					return fmt.Errorf("Inspector2 (%s) %s scanning is %s", accountID, resourceType, status)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnablerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_enabler" {
+			continue
+		}
+
+		accountIDs, resourceTypes, err := tfinspector2.EnablerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, accountID := range accountIDs {
+			output, err := tfinspector2.FindAccountStatusByID(conn, accountID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, resourceType := range resourceTypes {
+				var state *inspector2.State
+
+				switch resourceType {
+				case inspector2.ResourceScanTypeEc2:
+					state = output.ResourceState.Ec2
+				case inspector2.ResourceScanTypeEcr:
+					state = output.ResourceState.Ecr
+				}
+
+				if status := aws.StringValue(state.Status); status != inspector2.StatusDisabled {
+					return fmt.Errorf("Inspector2 (%s) %s scanning is still %s", accountID, resourceType, status)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccEnablerConfig(resourceTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = %[1]s
+}
+`, resourceTypes)
+}
@@ -0,0 +1,140 @@
+package inspector2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccountStatusByID(conn *inspector2.Inspector2, id string) (*inspector2.AccountState, error) {
+	input := &inspector2.BatchGetAccountStatusInput{
+		AccountIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.BatchGetAccountStatus(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.FailedAccounts {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.AccountId) == id {
+			return nil, &resource.NotFoundError{
+				Message:     aws.StringValue(v.ErrorMessage),
+				LastRequest: input,
+			}
+		}
+	}
+
+	for _, v := range output.Accounts {
+		if v == nil || v.ResourceState == nil || v.State == nil {
+			continue
+		}
+
+		if aws.StringValue(v.AccountId) == id {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func FindDelegatedAdminAccountByID(conn *inspector2.Inspector2, id string) (*inspector2.DelegatedAdminAccount, error) {
+	input := &inspector2.ListDelegatedAdminAccountsInput{}
+	var output *inspector2.DelegatedAdminAccount
+
+	err := conn.ListDelegatedAdminAccountsPages(input, func(page *inspector2.ListDelegatedAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DelegatedAdminAccounts {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.AccountId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMemberByAccountID(conn *inspector2.Inspector2, id string) (*inspector2.Member, error) {
+	input := &inspector2.GetMemberInput{
+		AccountId: aws.String(id),
+	}
+
+	output, err := conn.GetMember(input)
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Member == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Member.RelationshipStatus); status == inspector2.RelationshipStatusRemoved {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Member, nil
+}
+
+func FindOrganizationConfiguration(conn *inspector2.Inspector2) (*inspector2.DescribeOrganizationConfigurationOutput, error) {
+	input := &inspector2.DescribeOrganizationConfigurationInput{}
+
+	output, err := conn.DescribeOrganizationConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutoEnable == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
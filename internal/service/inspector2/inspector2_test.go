@@ -0,0 +1,38 @@
+package inspector2_test
+
+import (
+	"testing"
+)
+
+func TestAccInspector2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Enabler": {
+			"basic":         testAccEnabler_basic,
+			"disappears":    testAccEnabler_disappears,
+			"resourceTypes": testAccEnabler_resourceTypes,
+		},
+		"DelegatedAdminAccount": {
+			"basic":      testAccDelegatedAdminAccount_basic,
+			"disappears": testAccDelegatedAdminAccount_disappears,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccOrganizationConfiguration_basic,
+		},
+		"MemberAssociation": {
+			"basic":      testAccMemberAssociation_basic,
+			"disappears": testAccMemberAssociation_disappears,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
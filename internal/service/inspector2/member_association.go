@@ -0,0 +1,122 @@
+package inspector2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMemberAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMemberAssociationCreate,
+		Read:   resourceMemberAssociationRead,
+		Delete: resourceMemberAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"delegated_admin_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMemberAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountID := d.Get("account_id").(string)
+
+	input := &inspector2.AssociateMemberInput{
+		AccountId: aws.String(accountID),
+	}
+
+	_, err := conn.AssociateMember(input)
+
+	if err != nil {
+		return fmt.Errorf("error associating Inspector2 Member (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitMemberAssociated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Member (%s) to associate: %w", d.Id(), err)
+	}
+
+	return resourceMemberAssociationRead(d, meta)
+}
+
+func resourceMemberAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	member, err := FindMemberByAccountID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Member Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Member Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("delegated_admin_account_id", member.DelegatedAdminAccountId)
+	d.Set("relationship_status", member.RelationshipStatus)
+	if member.UpdatedAt != nil {
+		d.Set("updated_at", aws.TimeValue(member.UpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+
+	return nil
+}
+
+func resourceMemberAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[DEBUG] Disassociating Inspector2 Member: %s", d.Id())
+	_, err := conn.DisassociateMember(&inspector2.DisassociateMemberInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Inspector2 Member (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitMemberDisassociated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Member (%s) to disassociate: %w", d.Id(), err)
+	}
+
+	return nil
+}
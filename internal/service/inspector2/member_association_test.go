@@ -0,0 +1,139 @@
+package inspector2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccMemberAssociation_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_inspector2_member_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckMemberAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.member", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "delegated_admin_account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "relationship_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMemberAssociation_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_inspector2_member_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(inspector2.EndpointsID, t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckMemberAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceMemberAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMemberAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 Member Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		_, err := tfinspector2.FindMemberByAccountID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMemberAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_member_association" {
+			continue
+		}
+
+		_, err := tfinspector2.FindMemberByAccountID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Inspector2 Member Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMemberAssociationConfig() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
+data "aws_caller_identity" "current" {}
+
+data "aws_caller_identity" "member" {
+  provider = awsalternate
+}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_inspector2_member_association" "test" {
+  account_id = data.aws_caller_identity.member.account_id
+
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+}
+`)
+}
@@ -0,0 +1,150 @@
+package inspector2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrganizationConfigurationUpdate,
+		Read:   resourceOrganizationConfigurationRead,
+		Update: resourceOrganizationConfigurationUpdate,
+		Delete: resourceOrganizationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"ecr": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"max_account_limit_reached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	autoEnable := expandAutoEnable(d.Get("auto_enable").([]interface{}))
+
+	if err := updateOrganizationConfiguration(conn, autoEnable); err != nil {
+		return err
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceOrganizationConfigurationRead(d, meta)
+}
+
+func resourceOrganizationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	output, err := FindOrganizationConfiguration(conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Organization Configuration (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("auto_enable", flattenAutoEnable(output.AutoEnable)); err != nil {
+		return fmt.Errorf("error setting auto_enable: %w", err)
+	}
+
+	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
+
+	return nil
+}
+
+func resourceOrganizationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	autoEnable := &inspector2.AutoEnable{
+		Ec2: aws.Bool(false),
+		Ecr: aws.Bool(false),
+	}
+
+	return updateOrganizationConfiguration(conn, autoEnable)
+}
+
+func updateOrganizationConfiguration(conn *inspector2.Inspector2, autoEnable *inspector2.AutoEnable) error {
+	input := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: autoEnable,
+	}
+
+	log.Printf("[DEBUG] Updating Inspector2 Organization Configuration: %s", input)
+	_, err := conn.UpdateOrganizationConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Inspector2 Organization Configuration: %w", err)
+	}
+
+	if _, err := waitOrganizationConfigurationUpdated(conn, autoEnable); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Organization Configuration to update: %w", err)
+	}
+
+	return nil
+}
+
+func expandAutoEnable(tfList []interface{}) *inspector2.AutoEnable {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &inspector2.AutoEnable{}
+
+	if v, ok := tfMap["ec2"].(bool); ok {
+		apiObject.Ec2 = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ecr"].(bool); ok {
+		apiObject.Ecr = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenAutoEnable(apiObject *inspector2.AutoEnable) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ec2": aws.BoolValue(apiObject.Ec2),
+		"ecr": aws.BoolValue(apiObject.Ecr),
+	}
+
+	return []interface{}{tfMap}
+}
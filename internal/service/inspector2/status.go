@@ -0,0 +1,112 @@
+package inspector2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusEnabler(conn *inspector2.Inspector2, accountID, resourceType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAccountStatusByID(conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := resourceStateByType(output.ResourceState, resourceType)
+
+		if state == nil {
+			return nil, "", nil
+		}
+
+		// A failed enable drops back to DISABLED with the reason in the error message.
+		if status, message := aws.StringValue(state.Status), aws.StringValue(state.ErrorMessage); status == inspector2.StatusDisabled && message != "" {
+			return state, status, fmt.Errorf("%s: %s", aws.StringValue(state.ErrorCode), message)
+		}
+
+		return state, aws.StringValue(state.Status), nil
+	}
+}
+
+// statusDisabler does not fail on an error message, which remains set after a failed enable.
+func statusDisabler(conn *inspector2.Inspector2, accountID, resourceType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAccountStatusByID(conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := resourceStateByType(output.ResourceState, resourceType)
+
+		if state == nil {
+			return nil, "", nil
+		}
+
+		return state, aws.StringValue(state.Status), nil
+	}
+}
+
+func statusDelegatedAdminAccount(conn *inspector2.Inspector2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDelegatedAdminAccountByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusMember(conn *inspector2.Inspector2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMemberByAccountID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RelationshipStatus), nil
+	}
+}
+
+// statusOrganizationConfiguration reports whether the organization's auto-enable settings match those specified.
+func statusOrganizationConfiguration(conn *inspector2.Inspector2, autoEnable *inspector2.AutoEnable) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		equal := aws.BoolValue(output.AutoEnable.Ec2) == aws.BoolValue(autoEnable.Ec2) && aws.BoolValue(output.AutoEnable.Ecr) == aws.BoolValue(autoEnable.Ecr)
+
+		return output, strconv.FormatBool(equal), nil
+	}
+}
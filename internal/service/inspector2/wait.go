@@ -0,0 +1,149 @@
+package inspector2
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	delegatedAdminAccountEnabledTimeout  = 5 * time.Minute
+	delegatedAdminAccountDisabledTimeout = 5 * time.Minute
+
+	memberAssociatedTimeout    = 5 * time.Minute
+	memberDisassociatedTimeout = 5 * time.Minute
+
+	organizationConfigurationUpdatedTimeout = 5 * time.Minute
+)
+
+func waitEnabled(conn *inspector2.Inspector2, accountID, resourceType string, timeout time.Duration) (*inspector2.State, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.StatusDisabled, inspector2.StatusEnabling},
+		Target:  []string{inspector2.StatusEnabled},
+		Refresh: statusEnabler(conn, accountID, resourceType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.State); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDisabled(conn *inspector2.Inspector2, accountID, resourceType string, timeout time.Duration) (*inspector2.State, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.StatusDisabling, inspector2.StatusEnabled},
+		Target:  []string{inspector2.StatusDisabled},
+		Refresh: statusDisabler(conn, accountID, resourceType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.State); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDelegatedAdminAccountEnabled(conn *inspector2.Inspector2, id string) (*inspector2.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{inspector2.DelegatedAdminStatusEnabled},
+		Refresh: statusDelegatedAdminAccount(conn, id),
+		Timeout: delegatedAdminAccountEnabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDelegatedAdminAccountDisabled(conn *inspector2.Inspector2, id string) (*inspector2.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.DelegatedAdminStatusDisableInProgress, inspector2.DelegatedAdminStatusEnabled},
+		Target:  []string{},
+		Refresh: statusDelegatedAdminAccount(conn, id),
+		Timeout: delegatedAdminAccountDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemberAssociated(conn *inspector2.Inspector2, id string) (*inspector2.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{inspector2.RelationshipStatusCreated, inspector2.RelationshipStatusEnabled},
+		Refresh: statusMember(conn, id),
+		Timeout: memberAssociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemberDisassociated(conn *inspector2.Inspector2, id string) (*inspector2.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.RelationshipStatusCreated, inspector2.RelationshipStatusEnabled, inspector2.RelationshipStatusDisabled},
+		Target:  []string{},
+		Refresh: statusMember(conn, id),
+		Timeout: memberDisassociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationConfigurationUpdated(conn *inspector2.Inspector2, autoEnable *inspector2.AutoEnable) (*inspector2.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(false)},
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusOrganizationConfiguration(conn, autoEnable),
+		Timeout: organizationConfigurationUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.DescribeOrganizationConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,189 @@
+package kms_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Custom key stores require an active AWS CloudHSM cluster with at least two HSMs
+// and a "kmsuser" crypto user, which are too slow and costly to create per test.
+func testAccCustomKeyStorePreCheck(t *testing.T) (string, string, string) {
+	clusterID := os.Getenv("KMS_CUSTOM_KEY_STORE_CLUSTER_ID")
+	trustAnchorCertificate := os.Getenv("KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE")
+	password := os.Getenv("KMS_CUSTOM_KEY_STORE_PASSWORD")
+
+	if clusterID == "" || trustAnchorCertificate == "" || password == "" {
+		t.Skip("Environment variable KMS_CUSTOM_KEY_STORE_CLUSTER_ID, KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE, or KMS_CUSTOM_KEY_STORE_PASSWORD is not set")
+	}
+
+	return clusterID, trustAnchorCertificate, password
+}
+
+func TestAccKMSCustomKeyStore_basic(t *testing.T) {
+	clusterID, trustAnchorCertificate, password := testAccCustomKeyStorePreCheck(t)
+	var v kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig(rName, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_connected(t *testing.T) {
+	clusterID, trustAnchorCertificate, password := testAccCustomKeyStorePreCheck(t)
+	var v kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig(rName, clusterID, trustAnchorCertificate, password, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig(rNameUpdated, clusterID, trustAnchorCertificate, password, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rNameUpdated),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig(rNameUpdated, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_disappears(t *testing.T) {
+	clusterID, trustAnchorCertificate, password := testAccCustomKeyStorePreCheck(t)
+	var v kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig(rName, clusterID, trustAnchorCertificate, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkms.ResourceCustomKeyStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Custom Key Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomKeyStoreExists(name string, v *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+		output, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomKeyStoreConfig(rName, clusterID, trustAnchorCertificate, password string, connected bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id     = %[2]q
+  custom_key_store_name    = %[1]q
+  key_store_password       = %[4]q
+  trust_anchor_certificate = file(%[3]q)
+  connected                = %[5]t
+}
+`, rName, clusterID, trustAnchorCertificate, password, connected)
+}
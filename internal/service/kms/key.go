@@ -47,6 +47,12 @@ func ResourceKey() *schema.Resource {
 				Default:      kms.CustomerMasterKeySpecSymmetricDefault,
 				ValidateFunc: validation.StringInSlice(kms.CustomerMasterKeySpec_Values(), false),
 			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
 			"deletion_window_in_days": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -109,6 +115,11 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		KeyUsage:                       aws.String(d.Get("key_usage").(string)),
 	}
 
+	if v, ok := d.GetOk("custom_key_store_id"); ok {
+		input.CustomKeyStoreId = aws.String(v.(string))
+		input.Origin = aws.String(kms.OriginTypeAwsCloudhsm)
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -192,6 +203,7 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", key.metadata.Arn)
+	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", key.metadata.CustomerMasterKeySpec)
 	d.Set("description", key.metadata.Description)
 	d.Set("enable_key_rotation", key.rotation)
@@ -439,7 +451,10 @@ func updateKmsKeyPolicy(conn *kms.KMS, keyID string, policy string, bypassPolicy
 		}
 
 		log.Printf("[DEBUG] Updating KMS Key policy: %s", input)
-		_, err = conn.PutKeyPolicy(input)
+		// Principals referenced in the policy may have been created only recently.
+		_, err = WaitIAMPropagation(func() (interface{}, error) {
+			return conn.PutKeyPolicy(input)
+		})
 
 		return nil, err
 	}
@@ -27,6 +27,10 @@ func DataSourceKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_key_store_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"customer_master_key_spec": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -152,6 +156,7 @@ func dataSourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", keyMetadata.Arn)
 	d.Set("aws_account_id", keyMetadata.AWSAccountId)
 	d.Set("creation_date", aws.TimeValue(keyMetadata.CreationDate).Format(time.RFC3339))
+	d.Set("custom_key_store_id", keyMetadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", keyMetadata.CustomerMasterKeySpec)
 	if keyMetadata.DeletionDate != nil {
 		d.Set("deletion_date", aws.TimeValue(keyMetadata.DeletionDate).Format(time.RFC3339))
@@ -0,0 +1,142 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceKeyPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyPolicyPut,
+		Read:   resourceKeyPolicyRead,
+		Update: resourceKeyPolicyPut,
+		Delete: resourceKeyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bypass_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+		},
+	}
+}
+
+func resourceKeyPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	keyID := d.Get("key_id").(string)
+
+	if err := updateKmsKeyPolicy(conn, keyID, d.Get("policy").(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(keyID)
+
+	return resourceKeyPolicyRead(d, meta)
+}
+
+func resourceKeyPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		if _, err := FindKeyByID(conn, d.Id()); err != nil {
+			return nil, err
+		}
+
+		return FindKeyPolicyByKeyIDAndPolicyName(conn, d.Id(), PolicyNameDefault)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key (%s) not found, removing policy from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Key (%s) policy: %w", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(outputRaw.(*string)))
+
+	if err != nil {
+		return fmt.Errorf("policy contains invalid JSON: %w", err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), policy)
+
+	if err != nil {
+		return fmt.Errorf("while setting policy (%s), encountered: %w", policy, err)
+	}
+
+	d.Set("key_id", d.Id())
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceKeyPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	key, err := FindKeyByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Key (%s): %w", d.Id(), err)
+	}
+
+	// Restore the default key policy, which gives the key's AWS account full access to the key.
+	// See https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-default.html.
+	policy := defaultKeyPolicy(meta.(*conns.AWSClient).Partition, aws.StringValue(key.AWSAccountId))
+
+	log.Printf("[DEBUG] Restoring default KMS Key (%s) policy", d.Id())
+	if err := updateKmsKeyPolicy(conn, d.Id(), policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func defaultKeyPolicy(partition, accountID string) string {
+	return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "arn:%[1]s:iam::%[2]s:root"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`, partition, accountID)
+}
@@ -0,0 +1,236 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeyPolicy_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_key_policy.test"
+	expectedPolicyText := fmt.Sprintf(`{"Version":"2012-10-17","Id":%[1]q,"Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"}]}`, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					testAccCheckKeyHasPolicy(keyResourceName, expectedPolicyText),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_safety_check"},
+			},
+			{
+				Config: testAccKeyPolicyResourceIAMRoleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`kms:GenerateDataKey\*`)),
+				),
+			},
+			{
+				Config: testAccKeyPolicyRemovedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					testAccCheckKeyHasDefaultPolicy(keyResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicy_bypass(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_key_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyPolicyBypassConfig(rName, false),
+				ExpectError: regexp.MustCompile(`The new key policy will not allow you to update the key policy in the future`),
+			},
+			{
+				Config: testAccKeyPolicyBypassConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyHasDefaultPolicy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expectedPolicyText := fmt.Sprintf(`{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:%[1]s:iam::%[2]s:root"},"Action":"kms:*","Resource":"*"}]}`, acctest.Partition(), acctest.AccountID())
+
+		return testAccCheckKeyHasPolicy(name, expectedPolicyText)(s)
+	}
+}
+
+func testAccKeyPolicyBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+`, rName)
+}
+
+func testAccKeyPolicyConfig(rName string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key_policy" "test" {
+  key_id = aws_kms_key.test.id
+
+  policy = jsonencode({
+    Id = %[1]q
+    Statement = [{
+      Sid    = "Enable IAM User Permissions"
+      Effect = "Allow"
+      Principal = {
+        AWS = "*"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName))
+}
+
+func testAccKeyPolicyResourceIAMRoleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyBaseConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+
+  # The role references the key, and the key policy references the role.
+  inline_policy {
+    name = %[1]q
+
+    policy = jsonencode({
+      Statement = [{
+        Action   = ["kms:Decrypt", "kms:GenerateDataKey*"]
+        Effect   = "Allow"
+        Resource = aws_kms_key.test.arn
+      }]
+      Version = "2012-10-17"
+    })
+  }
+}
+
+resource "aws_kms_key_policy" "test" {
+  key_id = aws_kms_key.test.id
+
+  policy = jsonencode({
+    Id = %[1]q
+    Statement = [
+      {
+        Action = "kms:*"
+        Effect = "Allow"
+        Principal = {
+          AWS = "*"
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+      {
+        Action = [
+          "kms:Encrypt",
+          "kms:Decrypt",
+          "kms:ReEncrypt*",
+          "kms:GenerateDataKey*",
+          "kms:DescribeKey",
+        ]
+        Effect = "Allow"
+        Principal = {
+          AWS = aws_iam_role.test.arn
+        }
+        Resource = "*"
+        Sid      = "Allow use of the key"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+`, rName))
+}
+
+func testAccKeyPolicyRemovedConfig(rName string) string {
+	return testAccKeyPolicyBaseConfig(rName)
+}
+
+func testAccKeyPolicyBypassConfig(rName string, bypassFlag bool) string {
+	return acctest.ConfigCompose(testAccKeyPolicyBaseConfig(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_kms_key_policy" "test" {
+  key_id = aws_kms_key.test.id
+
+  bypass_policy_lockout_safety_check = %[2]t
+
+  policy = jsonencode({
+    Id = %[1]q
+    Statement = [
+      {
+        Action = [
+          "kms:CreateKey",
+          "kms:DescribeKey",
+          "kms:ScheduleKeyDeletion",
+          "kms:Describe*",
+          "kms:Get*",
+          "kms:List*",
+          "kms:TagResource",
+          "kms:UntagResource",
+        ]
+        Effect = "Allow"
+        Principal = {
+          AWS = data.aws_caller_identity.current.arn
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+`, rName, bypassFlag))
+}
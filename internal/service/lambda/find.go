@@ -43,3 +43,61 @@ func FindEventSourceMappingConfiguration(conn *lambda.Lambda, input *lambda.GetE
 
 	return output, nil
 }
+
+// FindFunctions returns the functions matching the specified input.
+func FindFunctions(conn *lambda.Lambda, input *lambda.ListFunctionsInput) ([]*lambda.FunctionConfiguration, error) {
+	var output []*lambda.FunctionConfiguration
+
+	err := conn.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindLayerVersions returns the versions of a layer matching the specified input.
+// Returns NotFoundError if the layer is not found.
+func FindLayerVersions(conn *lambda.Lambda, input *lambda.ListLayerVersionsInput) ([]*lambda.LayerVersionsListItem, error) {
+	var output []*lambda.LayerVersionsListItem
+
+	err := conn.ListLayerVersionsPages(input, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LayerVersions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -62,27 +62,55 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir", "source_file"},
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_file"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_file"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_file"},
 			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir", "source_file"},
+			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_file", "source_code_hash"},
+			},
+			"source_excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validSourceExcludePattern,
+				},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir", "source_code_hash"},
+			},
+			"source_symlinks": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      SourceSymlinksFollow,
+				ValidateFunc: validation.StringInSlice(SourceSymlinks_Values(), false),
+			},
+			"source_upload_bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"package_type": {
 				Type:         schema.TypeString,
@@ -333,6 +361,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			sourceArchiveCustomizeDiff,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -402,9 +431,11 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 	imageUri, hasImageUri := d.GetOk("image_uri")
+	_, hasSourceDir := d.GetOk("source_dir")
+	_, hasSourceFile := d.GetOk("source_file")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri {
-		return errors.New("filename, s3_* or image_uri attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri && !hasSourceDir && !hasSourceFile {
+		return errors.New("filename, source_dir, source_file, s3_* or image_uri attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
@@ -421,6 +452,14 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		functionCode = &lambda.FunctionCode{
 			ZipFile: file,
 		}
+	} else if hasSourceDir || hasSourceFile {
+		conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+		defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+		code, err := packageSourceArchive(d, meta, functionName)
+		if err != nil {
+			return fmt.Errorf("error packaging Lambda Function (%s) source: %w", functionName, err)
+		}
+		functionCode = expandFunctionCodeFromSourceArchive(code)
 	} else if hasImageUri {
 		functionCode = &lambda.FunctionCode{
 			ImageUri: aws.String(imageUri.(string)),
@@ -1167,6 +1206,22 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("unable to load %q: %w", v.(string), err)
 			}
 			codeReq.ZipFile = file
+		} else if _, ok := expandSourceArchiveOptions(d); ok {
+			conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+			defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+			code, err := packageSourceArchive(d, meta, d.Id())
+			if err != nil {
+				return fmt.Errorf("error packaging Lambda Function (%s) source: %w", d.Id(), err)
+			}
+			if code.ZipFile != nil {
+				codeReq.ZipFile = code.ZipFile
+			} else {
+				codeReq.S3Bucket = aws.String(code.S3Bucket)
+				codeReq.S3Key = aws.String(code.S3Key)
+				if code.S3ObjectVersion != "" {
+					codeReq.S3ObjectVersion = aws.String(code.S3ObjectVersion)
+				}
+			}
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			codeReq.ImageUri = aws.String(v.(string))
 		} else {
@@ -1265,6 +1320,25 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceFunctionRead(d, meta)
 }
 
+func expandFunctionCodeFromSourceArchive(code *sourceArchivePackage) *lambda.FunctionCode {
+	if code.ZipFile != nil {
+		return &lambda.FunctionCode{
+			ZipFile: code.ZipFile,
+		}
+	}
+
+	functionCode := &lambda.FunctionCode{
+		S3Bucket: aws.String(code.S3Bucket),
+		S3Key:    aws.String(code.S3Key),
+	}
+
+	if code.S3ObjectVersion != "" {
+		functionCode.S3ObjectVersion = aws.String(code.S3ObjectVersion)
+	}
+
+	return functionCode
+}
+
 // loadFileContent returns contents of a file in a given path
 func loadFileContent(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
@@ -1880,6 +1880,97 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	dir := t.TempDir()
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopyFile(t, "test-fixtures/lambda_func.js", filepath.Join(dir, "lambda.js"))
+					testAccWriteFile(t, filepath.Join(dir, "README.md"), "v1")
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_excludes", "source_symlinks"},
+			},
+			{
+				// Changes to excluded files must not cause a code update.
+				PreConfig: func() {
+					testAccWriteFile(t, filepath.Join(dir, "README.md"), "v2")
+				},
+				Config:   testAccFunctionConfig_sourceDir(dir, roleName, funcName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccCopyFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(dir, "lambda.js"))
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_sourceFile(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_file_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_file_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceFile("test-fixtures/lambda_func.js", roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+				),
+			},
+			{
+				Config:   testAccFunctionConfig_sourceFile("test-fixtures/lambda_func.js", roleName, funcName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -2340,6 +2431,21 @@ func createTempFile(prefix string) (string, *os.File, error) {
 	return pathToFile, f, nil
 }
 
+func testAccCopyFile(t *testing.T, src, dst string) {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccWriteFile(t, dst, string(content))
+}
+
+func testAccWriteFile(t *testing.T, filename, content string) {
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccBasicConfig(funcName, policyName, roleName, sgName string) string {
 	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
 resource "aws_lambda_function" "test" {
@@ -3589,3 +3695,52 @@ func testAccPreCheckSignerSigningProfile(t *testing.T, platformID string) {
 		t.Skipf("skipping acceptance testing: Signing Platform (%s) not found", platformID)
 	}
 }
+
+func testAccFunctionSourceArchiveBaseConfig(roleName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+`, roleName)
+}
+
+func testAccFunctionConfig_sourceDir(dir, roleName, funcName string) string {
+	return acctest.ConfigCompose(testAccFunctionSourceArchiveBaseConfig(roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir      = %[1]q
+  source_excludes = ["*.md"]
+  function_name   = %[2]q
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "lambda.handler"
+  runtime         = "nodejs12.x"
+}
+`, dir, funcName))
+}
+
+func testAccFunctionConfig_sourceFile(filename, roleName, funcName string) string {
+	return acctest.ConfigCompose(testAccFunctionSourceArchiveBaseConfig(roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_file   = %[1]q
+  function_name = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda_func.handler"
+  runtime       = "nodejs12.x"
+}
+`, filename, funcName))
+}
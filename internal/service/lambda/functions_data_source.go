@@ -0,0 +1,143 @@
+package lambda
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"functions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architectures": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"deprecated_runtime": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"function_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runtime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"runtime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	functions, err := FindFunctions(conn, &lambda.ListFunctionsInput{})
+
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions: %w", err)
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	runtime := d.Get("runtime").(string)
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	now := time.Now()
+
+	var functionARNs, functionNames []string
+	var tfList []interface{}
+
+	for _, function := range functions {
+		functionARN := aws.StringValue(function.FunctionArn)
+		functionName := aws.StringValue(function.FunctionName)
+
+		if nameRegex != nil && !nameRegex.MatchString(functionName) {
+			continue
+		}
+
+		if runtime != "" && aws.StringValue(function.Runtime) != runtime {
+			continue
+		}
+
+		// Tags are not returned by ListFunctions.
+		if len(tagsToMatch) > 0 {
+			tags, err := ListTags(conn, functionARN)
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for Lambda Function (%s): %w", functionARN, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		functionARNs = append(functionARNs, functionARN)
+		functionNames = append(functionNames, functionName)
+		tfList = append(tfList, map[string]interface{}{
+			"architectures":      flex.FlattenStringList(function.Architectures),
+			"deprecated_runtime": RuntimeDeprecated(aws.StringValue(function.Runtime), now),
+			"function_arn":       functionARN,
+			"function_name":      functionName,
+			"runtime":            aws.StringValue(function.Runtime),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("function_arns", functionARNs)
+	d.Set("function_names", functionNames)
+	if err := d.Set("functions", tfList); err != nil {
+		return fmt.Errorf("error setting functions: %w", err)
+	}
+
+	return nil
+}
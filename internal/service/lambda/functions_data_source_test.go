@@ -0,0 +1,104 @@
+package lambda_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "function_names.#", regexp.MustCompile("[^0].*$")),
+					resource.TestMatchResourceAttr(dataSourceName, "function_arns.#", regexp.MustCompile("[^0].*$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionsDataSource_filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_names.0", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.architectures.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.architectures.0", "x86_64"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.deprecated_runtime", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "functions.0.function_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "functions.0.function_name", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.runtime", "provided.al2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionsDataSourceConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionBaseDataSourceConfig(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  count = 2
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-${count.index}"
+  handler       = "bootstrap"
+  role          = aws_iam_role.lambda.arn
+  runtime       = "provided.al2"
+
+  tags = {
+    Name  = %[1]q
+    Index = count.index
+  }
+}
+`, rName))
+}
+
+func testAccFunctionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionsDataSourceConfigBase(rName), `
+data "aws_lambda_functions" "test" {
+  depends_on = [aws_lambda_function.test]
+}
+`)
+}
+
+func testAccFunctionsDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionsDataSourceConfigBase(rName), fmt.Sprintf(`
+data "aws_lambda_functions" "test" {
+  name_regex = "^%[1]s-"
+  runtime    = "provided.al2"
+
+  tags = {
+    Index = "0"
+  }
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName))
+}
@@ -68,7 +68,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir", "source_file"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -89,19 +89,19 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir", "source_file"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir", "source_file"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir", "source_file"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -127,11 +127,46 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_file", "source_code_hash"},
+			},
+			"source_excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validSourceExcludePattern,
+				},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir", "source_code_hash"},
+			},
+			"source_symlinks": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      SourceSymlinksFollow,
+				ValidateFunc: validation.StringInSlice(SourceSymlinks_Values(), false),
+			},
+			"source_upload_bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: sourceArchiveCustomizeDiff,
 	}
 }
 
@@ -143,9 +178,11 @@ func resourceLayerVersionPublish(d *schema.ResourceData, meta interface{}) error
 	s3Bucket, bucketOk := d.GetOk("s3_bucket")
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
+	_, hasSourceDir := d.GetOk("source_dir")
+	_, hasSourceFile := d.GetOk("source_file")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return errors.New("filename or s3_* attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasSourceDir && !hasSourceFile {
+		return errors.New("filename, source_dir, source_file or s3_* attributes must be set")
 	}
 
 	var layerContent *lambda.LayerVersionContentInput
@@ -159,6 +196,26 @@ func resourceLayerVersionPublish(d *schema.ResourceData, meta interface{}) error
 		layerContent = &lambda.LayerVersionContentInput{
 			ZipFile: file,
 		}
+	} else if hasSourceDir || hasSourceFile {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+		code, err := packageSourceArchive(d, meta, layerName)
+		if err != nil {
+			return fmt.Errorf("error packaging Lambda Layer (%s) source: %w", layerName, err)
+		}
+		if code.ZipFile != nil {
+			layerContent = &lambda.LayerVersionContentInput{
+				ZipFile: code.ZipFile,
+			}
+		} else {
+			layerContent = &lambda.LayerVersionContentInput{
+				S3Bucket: aws.String(code.S3Bucket),
+				S3Key:    aws.String(code.S3Key),
+			}
+			if code.S3ObjectVersion != "" {
+				layerContent.S3ObjectVersion = aws.String(code.S3ObjectVersion)
+			}
+		}
 	} else {
 		if !bucketOk || !keyOk {
 			return errors.New("s3_bucket and s3_key must all be set while using s3 code source")
@@ -107,6 +107,32 @@ func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceFile(t *testing.T) {
+	resourceName := "aws_lambda_layer_version.lambda_layer_test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLayerVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionSourceFile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(resourceName, rName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+				),
+			},
+			{
+				Config:   testAccLayerVersionSourceFile(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_compatibleRuntimes(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -363,6 +389,15 @@ resource "aws_lambda_layer_version" "lambda_layer_test" {
 `, rName)
 }
 
+func testAccLayerVersionSourceFile(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "lambda_layer_test" {
+  source_file = "test-fixtures/lambda_func.js"
+  layer_name  = %[1]q
+}
+`, rName)
+}
+
 func testAccLayerVersionCreateBeforeDestroy(rName string, filename string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "lambda_layer_test" {
@@ -0,0 +1,140 @@
+package lambda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceLayerVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLayerVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"compatible_architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Architecture_Values(), false),
+			},
+			"compatible_runtime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"layer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"layer_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compatible_architectures": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"compatible_runtimes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deprecated_runtime": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLayerVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	layerName := d.Get("layer_name").(string)
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+
+	if v, ok := d.GetOk("compatible_architecture"); ok {
+		input.CompatibleArchitecture = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("compatible_runtime"); ok {
+		input.CompatibleRuntime = aws.String(v.(string))
+	}
+
+	layerVersions, err := FindLayerVersions(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Layer Versions (%s): %w", layerName, err)
+	}
+
+	now := time.Now()
+	var tfList []interface{}
+
+	for _, layerVersion := range layerVersions {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                      aws.StringValue(layerVersion.LayerVersionArn),
+			"compatible_architectures": flex.FlattenStringList(layerVersion.CompatibleArchitectures),
+			"compatible_runtimes":      flex.FlattenStringList(layerVersion.CompatibleRuntimes),
+			"created_date":             aws.StringValue(layerVersion.CreatedDate),
+			"deprecated_runtime":       layerVersionRuntimesDeprecated(layerVersion.CompatibleRuntimes, now),
+			"description":              aws.StringValue(layerVersion.Description),
+			"license_info":             aws.StringValue(layerVersion.LicenseInfo),
+			"version":                  int(aws.Int64Value(layerVersion.Version)),
+		})
+	}
+
+	d.SetId(layerName)
+	if err := d.Set("layer_versions", tfList); err != nil {
+		return fmt.Errorf("error setting layer_versions: %w", err)
+	}
+
+	return nil
+}
+
+// layerVersionRuntimesDeprecated returns whether all of a layer version's compatible runtimes are deprecated.
+// A layer version that declares no compatible runtimes is never considered deprecated.
+func layerVersionRuntimesDeprecated(runtimes []*string, t time.Time) bool {
+	if len(runtimes) == 0 {
+		return false
+	}
+
+	for _, runtime := range runtimes {
+		if !RuntimeDeprecated(aws.StringValue(runtime), t) {
+			return false
+		}
+	}
+
+	return true
+}
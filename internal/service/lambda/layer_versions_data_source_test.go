@@ -0,0 +1,98 @@
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "layer_versions.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "layer_versions.*", map[string]string{
+						"compatible_runtimes.#": "1",
+						"compatible_runtimes.0": "python2.7",
+						"deprecated_runtime":    "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "layer_versions.*", map[string]string{
+						"compatible_runtimes.#": "1",
+						"compatible_runtimes.0": "provided.al2",
+						"deprecated_runtime":    "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleRuntime(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test_two"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.deprecated_runtime", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["python2.7"]
+}
+
+resource "aws_lambda_layer_version" "test_two" {
+  filename            = "test-fixtures/lambdatest_modified.zip"
+  layer_name          = aws_lambda_layer_version.test.layer_name
+  compatible_runtimes = ["provided.al2"]
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfigBase(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test_two.layer_name
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfigBase(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.test_two.layer_name
+  compatible_runtime = "provided.al2"
+}
+`)
+}
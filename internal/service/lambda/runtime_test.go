@@ -0,0 +1,92 @@
+package lambda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+func TestRuntimeDeprecated(t *testing.T) {
+	testCases := []struct {
+		runtime  string
+		date     string
+		expected bool
+	}{
+		{
+			runtime:  lambda.RuntimePython27,
+			date:     "2021-07-14",
+			expected: false,
+		},
+		{
+			runtime:  lambda.RuntimePython27,
+			date:     "2021-07-15",
+			expected: true,
+		},
+		{
+			runtime:  lambda.RuntimeNodejs14X,
+			date:     "2024-01-01",
+			expected: true,
+		},
+		{
+			runtime:  lambda.RuntimeProvidedAl2,
+			date:     "2030-01-01",
+			expected: true,
+		},
+		{
+			runtime:  lambda.RuntimeJava8Al2,
+			date:     "2026-06-29",
+			expected: false,
+		},
+		{
+			runtime:  lambda.RuntimeJava8Al2,
+			date:     "2026-06-30",
+			expected: true,
+		},
+		{
+			runtime:  lambda.RuntimeJava11,
+			date:     "2026-06-30",
+			expected: true,
+		},
+		{
+			runtime:  "nodejs18.x",
+			date:     "2025-08-31",
+			expected: false,
+		},
+		{
+			runtime:  "nodejs18.x",
+			date:     "2025-09-01",
+			expected: true,
+		},
+		{
+			runtime:  "nodejs99.x",
+			date:     "2030-01-01",
+			expected: false,
+		},
+		{
+			runtime:  "",
+			date:     "2030-01-01",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		date, err := time.Parse("2006-01-02", testCase.date)
+
+		if err != nil {
+			t.Fatalf("error parsing date (%s): %s", testCase.date, err)
+		}
+
+		if got := RuntimeDeprecated(testCase.runtime, date); got != testCase.expected {
+			t.Errorf("RuntimeDeprecated(%q, %s) = %t, expected %t", testCase.runtime, testCase.date, got, testCase.expected)
+		}
+	}
+}
+
+func TestRuntimeDeprecationDates(t *testing.T) {
+	for runtime := range runtimeDeprecationDates {
+		if _, ok := RuntimeDeprecationDate(runtime); !ok {
+			t.Errorf("invalid deprecation date for runtime (%s): %s", runtime, runtimeDeprecationDates[runtime])
+		}
+	}
+}
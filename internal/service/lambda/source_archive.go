@@ -0,0 +1,409 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	SourceSymlinksFollow   = "follow"
+	SourceSymlinksPreserve = "preserve"
+	SourceSymlinksSkip     = "skip"
+)
+
+func SourceSymlinks_Values() []string {
+	return []string{
+		SourceSymlinksFollow,
+		SourceSymlinksPreserve,
+		SourceSymlinksSkip,
+	}
+}
+
+const (
+	// Archives larger than this must be uploaded to S3 rather than passed directly to the Lambda API.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceArchiveDirectUploadMaxSize = 50 * 1024 * 1024
+)
+
+// sourceArchiveModTime is the modification time recorded for every archive entry.
+// It is the earliest time representable in the MS-DOS format used by zip.
+var sourceArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// sourceArchiveConfig is implemented by both schema.ResourceData and schema.ResourceDiff.
+type sourceArchiveConfig interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+type sourceArchiveOptions struct {
+	dir      string
+	file     string
+	excludes []string
+	symlinks string
+}
+
+type sourceArchiveEntry struct {
+	name string
+	path string
+	mode os.FileMode
+	link string
+}
+
+// sourceArchivePackage is the result of packaging source code.
+// Either ZipFile or the S3 location is set.
+type sourceArchivePackage struct {
+	ZipFile         []byte
+	S3Bucket        string
+	S3Key           string
+	S3ObjectVersion string
+}
+
+// expandSourceArchiveOptions returns the source packaging options configured for the resource, if any.
+func expandSourceArchiveOptions(d sourceArchiveConfig) (*sourceArchiveOptions, bool) {
+	opts := &sourceArchiveOptions{
+		symlinks: d.Get("source_symlinks").(string),
+	}
+
+	if v, ok := d.GetOk("source_dir"); ok {
+		opts.dir = v.(string)
+	} else if v, ok := d.GetOk("source_file"); ok {
+		opts.file = v.(string)
+	} else {
+		return nil, false
+	}
+
+	if v, ok := d.GetOk("source_excludes"); ok && v.(*schema.Set).Len() > 0 {
+		for _, v := range v.(*schema.Set).List() {
+			opts.excludes = append(opts.excludes, v.(string))
+		}
+	}
+
+	return opts, true
+}
+
+// build returns a reproducible zip archive of the source directory or file.
+// Entries are sorted by name, have a fixed modification time and normalized permissions
+// so that the archive (and therefore its hash) only changes when file names or contents change.
+func (o *sourceArchiveOptions) build() ([]byte, error) {
+	var entries []sourceArchiveEntry
+
+	if o.file != "" {
+		filename, err := homedir.Expand(o.file)
+
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := os.Stat(filename)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil, fmt.Errorf("%s is not a regular file", o.file)
+		}
+
+		entries = append(entries, sourceArchiveEntry{
+			name: filepath.Base(filename),
+			path: filename,
+			mode: info.Mode(),
+		})
+	} else {
+		dir, err := homedir.Expand(o.dir)
+
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := os.Stat(dir)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s is not a directory", o.dir)
+		}
+
+		if err := o.collect(dir, "", make(map[string]bool), &entries); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no files to archive")
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	for _, entry := range entries {
+		header := &zip.FileHeader{
+			Name:     entry.name,
+			Method:   zip.Deflate,
+			Modified: sourceArchiveModTime,
+		}
+
+		if entry.link != "" {
+			header.Method = zip.Store
+			header.SetMode(os.ModeSymlink | 0777)
+
+			f, err := w.CreateHeader(header)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := io.WriteString(f, entry.link); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		// Preserve only the executable bit, e.g. for custom runtime "bootstrap" files.
+		if entry.mode&0111 != 0 {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		f, err := w.CreateHeader(header)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := copySourceArchiveFile(f, entry.path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (o *sourceArchiveOptions) collect(dir, prefix string, visited map[string]bool, entries *[]sourceArchiveEntry) error {
+	realDir, err := filepath.EvalSymlinks(dir)
+
+	if err != nil {
+		return err
+	}
+
+	if visited[realDir] {
+		return fmt.Errorf("symbolic link cycle detected at %s", dir)
+	}
+
+	visited[realDir] = true
+	defer delete(visited, realDir)
+
+	items, err := os.ReadDir(dir)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		name := path.Join(prefix, item.Name())
+
+		if o.excluded(name) {
+			log.Printf("[DEBUG] Excluding %s from Lambda source archive", name)
+			continue
+		}
+
+		filename := filepath.Join(dir, item.Name())
+		info, err := os.Lstat(filename)
+
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			switch o.symlinks {
+			case SourceSymlinksSkip:
+				continue
+			case SourceSymlinksPreserve:
+				target, err := os.Readlink(filename)
+
+				if err != nil {
+					return err
+				}
+
+				*entries = append(*entries, sourceArchiveEntry{
+					name: name,
+					link: filepath.ToSlash(target),
+				})
+
+				continue
+			default:
+				info, err = os.Stat(filename)
+
+				if err != nil {
+					return fmt.Errorf("error following symbolic link %s: %w", filename, err)
+				}
+			}
+		}
+
+		if info.IsDir() {
+			if err := o.collect(filename, name, visited, entries); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		*entries = append(*entries, sourceArchiveEntry{
+			name: name,
+			path: filename,
+			mode: info.Mode(),
+		})
+	}
+
+	return nil
+}
+
+// excluded returns whether the slash-separated path relative to the source directory matches any exclude pattern.
+// Patterns without a slash are also matched against the base name, so "*.pyc" excludes such files at any depth.
+// Excluding a directory excludes everything beneath it.
+func (o *sourceArchiveOptions) excluded(name string) bool {
+	for _, pattern := range o.excludes {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(name)); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func copySourceArchiveFile(w io.Writer, filename string) error {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+
+	return err
+}
+
+// sourceArchiveHash returns the base64-encoded SHA-256 hash of the archive.
+// This is the same as the CodeSha256 value reported by the Lambda API and the filebase64sha256() function.
+func sourceArchiveHash(archive []byte) string {
+	hash := sha256.Sum256(archive)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// packageSourceArchive builds the source archive and returns it directly,
+// or uploads it to the configured S3 bucket if it is too large for direct upload.
+func packageSourceArchive(d *schema.ResourceData, meta interface{}, name string) (*sourceArchivePackage, error) {
+	opts, ok := expandSourceArchiveOptions(d)
+
+	if !ok {
+		return nil, fmt.Errorf("source_dir or source_file must be set")
+	}
+
+	archive, err := opts.build()
+
+	if err != nil {
+		return nil, fmt.Errorf("error building source archive: %w", err)
+	}
+
+	if len(archive) <= sourceArchiveDirectUploadMaxSize {
+		return &sourceArchivePackage{
+			ZipFile: archive,
+		}, nil
+	}
+
+	bucket := d.Get("source_upload_bucket").(string)
+
+	if bucket == "" {
+		return nil, fmt.Errorf("source archive size (%d bytes) exceeds the direct upload limit (%d bytes) and source_upload_bucket is not set", len(archive), sourceArchiveDirectUploadMaxSize)
+	}
+
+	hash := sha256.Sum256(archive)
+	key := fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(hash[:]))
+
+	log.Printf("[DEBUG] Uploading Lambda source archive (%d bytes) to s3://%s/%s", len(archive), bucket, key)
+	output, err := s3manager.NewUploaderWithClient(meta.(*conns.AWSClient).S3Conn).Upload(&s3manager.UploadInput{
+		Body:   bytes.NewReader(archive),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error uploading source archive to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return &sourceArchivePackage{
+		S3Bucket:        bucket,
+		S3Key:           key,
+		S3ObjectVersion: aws.StringValue(output.VersionID),
+	}, nil
+}
+
+// sourceArchiveCustomizeDiff computes source_code_hash at plan time from the source directory or file
+// so that a code change is planned only when the packaged contents change.
+func sourceArchiveCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_file") || !d.NewValueKnown("source_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	opts, ok := expandSourceArchiveOptions(d)
+
+	if !ok {
+		return nil
+	}
+
+	archive, err := opts.build()
+
+	if err != nil {
+		return fmt.Errorf("error building source archive: %w", err)
+	}
+
+	if hash := sourceArchiveHash(archive); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSourceArchiveBuild(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"index.js":                "exports.handler = () => {}",
+		"lib/util.js":             "module.exports = {}",
+		"README.md":               "readme",
+		"node_modules/x/index.js": "x",
+	}
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Symlink(filepath.Join(dir, "index.js"), filepath.Join(dir, "link.js")); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name      string
+		opts      sourceArchiveOptions
+		wantNames []string
+	}{
+		{
+			name:      "follow symlinks",
+			opts:      sourceArchiveOptions{dir: dir, symlinks: SourceSymlinksFollow},
+			wantNames: []string{"README.md", "index.js", "lib/util.js", "link.js", "node_modules/x/index.js"},
+		},
+		{
+			name:      "skip symlinks",
+			opts:      sourceArchiveOptions{dir: dir, symlinks: SourceSymlinksSkip},
+			wantNames: []string{"README.md", "index.js", "lib/util.js", "node_modules/x/index.js"},
+		},
+		{
+			name:      "excludes",
+			opts:      sourceArchiveOptions{dir: dir, symlinks: SourceSymlinksSkip, excludes: []string{"*.md", "node_modules"}},
+			wantNames: []string{"index.js", "lib/util.js"},
+		},
+		{
+			name:      "single file",
+			opts:      sourceArchiveOptions{file: filepath.Join(dir, "lib", "util.js")},
+			wantNames: []string{"util.js"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			archive, err := testCase.opts.build()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotNames []string
+			for _, f := range r.File {
+				gotNames = append(gotNames, f.Name)
+
+				if !f.Modified.Equal(sourceArchiveModTime) {
+					t.Errorf("%s: got modification time %s, expected %s", f.Name, f.Modified, sourceArchiveModTime)
+				}
+
+				if got, want := f.Mode().Perm(), os.FileMode(0644); got != want {
+					t.Errorf("%s: got mode %s, expected %s", f.Name, got, want)
+				}
+			}
+
+			if len(gotNames) != len(testCase.wantNames) {
+				t.Fatalf("got entries %v, expected %v", gotNames, testCase.wantNames)
+			}
+
+			for i := range gotNames {
+				if gotNames[i] != testCase.wantNames[i] {
+					t.Fatalf("got entries %v, expected %v", gotNames, testCase.wantNames)
+				}
+			}
+
+			again, err := testCase.opts.build()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := sourceArchiveHash(again), sourceArchiveHash(archive); got != want {
+				t.Errorf("archive is not reproducible: got hash %s, expected %s", got, want)
+			}
+		})
+	}
+}
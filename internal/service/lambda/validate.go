@@ -2,6 +2,7 @@ package lambda
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 )
 
@@ -89,3 +90,13 @@ func validPolicyStatementID(v interface{}, k string) (ws []string, errors []erro
 
 	return
 }
+
+func validSourceExcludePattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid glob pattern: %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,1510 @@
+package mediaconnect
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFlowCreate,
+		Read:   resourceFlowRead,
+		Update: resourceFlowUpdate,
+		Delete: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": flowEncryptionSchema(),
+						"entitlement_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_allow_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": flowEncryptionSchema(),
+						"listener_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"output_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"decryption": flowEncryptionSchema(),
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.FailoverMode_Values(), false),
+						},
+						"primary_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      mediaconnect.StateEnabled,
+							ValidateFunc: validation.StringInSlice(mediaconnect.State_Values(), false),
+						},
+					},
+				},
+			},
+			"start_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.NetworkInterfaceType_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func flowEncryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.Algorithm_Values(), false),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.KeyType_Values(), false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source"); ok && len(v.([]interface{})) > 0 {
+		if sources := expandSetSourceRequests(v.([]interface{})); len(sources) == 1 {
+			input.Source = sources[0]
+		} else {
+			input.Sources = sources
+		}
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		input.VpcInterfaces = expandVpcInterfaceRequests(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConnect Flow: %s", input)
+	output, err := conn.CreateFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConnect Flow (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := WaitFlowCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) create: %w", d.Id(), err)
+	}
+
+	if len(tags) > 0 {
+		if err := UpdateTags(conn, d.Id(), nil, tags); err != nil {
+			return fmt.Errorf("error adding MediaConnect Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	if d.Get("start_flow").(bool) {
+		if err := startFlow(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceFlowRead(d, meta)
+}
+
+func resourceFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	flow, err := FindFlowByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", flattenEntitlements(flow.Entitlements)); err != nil {
+		return fmt.Errorf("error setting entitlement: %w", err)
+	}
+	d.Set("name", flow.Name)
+	if err := d.Set("output", flattenOutputs(flow.Outputs)); err != nil {
+		return fmt.Errorf("error setting output: %w", err)
+	}
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []*mediaconnect.Source{flow.Source}
+	}
+	if err := d.Set("source", flattenSources(sources)); err != nil {
+		return fmt.Errorf("error setting source: %w", err)
+	}
+	if flow.SourceFailoverConfig != nil {
+		if err := d.Set("source_failover_config", []interface{}{flattenFailoverConfig(flow.SourceFailoverConfig)}); err != nil {
+			return fmt.Errorf("error setting source_failover_config: %w", err)
+		}
+	} else {
+		d.Set("source_failover_config", nil)
+	}
+	d.Set("start_flow", aws.StringValue(flow.Status) == mediaconnect.StatusActive)
+	d.Set("status", flow.Status)
+	if err := d.Set("vpc_interface", flattenVpcInterfaces(flow.VpcInterfaces)); err != nil {
+		return fmt.Errorf("error setting vpc_interface: %w", err)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+
+	if d.HasChangesExcept("start_flow", "tags", "tags_all") {
+		flow, err := FindFlowByARN(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading MediaConnect Flow (%s): %w", d.Id(), err)
+		}
+
+		// Sources, outputs and VPC interfaces of an active flow cannot all be modified,
+		// so stop the flow first. It is restarted below if requested.
+		if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+			if err := stopFlow(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		if err := updateFlow(conn, d); err != nil {
+			return err
+		}
+
+		if _, err := WaitFlowUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaConnect Flow (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		flow, err := FindFlowByARN(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading MediaConnect Flow (%s): %w", d.Id(), err)
+		}
+
+		active := aws.StringValue(flow.Status) == mediaconnect.StatusActive
+
+		if start := d.Get("start_flow").(bool); start && !active {
+			if err := startFlow(conn, d.Id()); err != nil {
+				return err
+			}
+		} else if !start && active {
+			if err := stopFlow(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFlowRead(d, meta)
+}
+
+func resourceFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+
+	flow, err := FindFlowByARN(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+		if err := stopFlow(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlow(&mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitFlowDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateFlow reconciles the flow's VPC interfaces, failover configuration, sources, outputs and entitlements.
+// Elements are matched by name. VPC interfaces are added first and removed last as sources and outputs may reference them.
+func updateFlow(conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	arn := d.Id()
+	o, n := d.GetChange("vpc_interface")
+	vpcInterfacesToAdd, vpcInterfacesToRemove := []interface{}{}, []string{}
+	os, ns := flowElementsByName(o.([]interface{})), flowElementsByName(n.([]interface{}))
+
+	for name, tfMap := range ns {
+		if old, ok := os[name]; !ok {
+			vpcInterfacesToAdd = append(vpcInterfacesToAdd, tfMap)
+		} else if !reflect.DeepEqual(expandVpcInterfaceRequest(old), expandVpcInterfaceRequest(tfMap)) {
+			// VPC interfaces cannot be modified in place.
+			if err := removeFlowVpcInterface(conn, arn, name); err != nil {
+				return err
+			}
+
+			vpcInterfacesToAdd = append(vpcInterfacesToAdd, tfMap)
+		}
+	}
+
+	for name := range os {
+		if _, ok := ns[name]; !ok {
+			vpcInterfacesToRemove = append(vpcInterfacesToRemove, name)
+		}
+	}
+
+	if len(vpcInterfacesToAdd) > 0 {
+		input := &mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn:       aws.String(arn),
+			VpcInterfaces: expandVpcInterfaceRequests(vpcInterfacesToAdd),
+		}
+
+		log.Printf("[DEBUG] Adding MediaConnect Flow VPC interfaces: %s", input)
+		if _, err := conn.AddFlowVpcInterfaces(input); err != nil {
+			return fmt.Errorf("error adding MediaConnect Flow (%s) VPC interfaces: %w", arn, err)
+		}
+	}
+
+	if d.HasChange("source_failover_config") {
+		input := &mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(arn),
+		}
+
+		if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SourceFailoverConfig = expandUpdateFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.SourceFailoverConfig = &mediaconnect.UpdateFailoverConfig{
+				State: aws.String(mediaconnect.StateDisabled),
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow: %s", input)
+		if _, err := conn.UpdateFlow(input); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s): %w", arn, err)
+		}
+	}
+
+	var sourcesToRemove []string
+	if d.HasChange("source") {
+		o, n := d.GetChange("source")
+		os, ns := flowElementsByName(o.([]interface{})), flowElementsByName(n.([]interface{}))
+		var sourcesToAdd []interface{}
+
+		for name, tfMap := range ns {
+			old, ok := os[name]
+
+			if !ok {
+				sourcesToAdd = append(sourcesToAdd, tfMap)
+				continue
+			}
+
+			if reflect.DeepEqual(expandSetSourceRequest(old), expandSetSourceRequest(tfMap)) {
+				continue
+			}
+
+			input := expandUpdateFlowSourceInput(tfMap)
+			input.FlowArn = aws.String(arn)
+			input.SourceArn = aws.String(old["source_arn"].(string))
+
+			log.Printf("[DEBUG] Updating MediaConnect Flow source: %s", input)
+			if _, err := conn.UpdateFlowSource(input); err != nil {
+				return fmt.Errorf("error updating MediaConnect Flow (%s) source (%s): %w", arn, name, err)
+			}
+		}
+
+		for name, tfMap := range os {
+			if _, ok := ns[name]; !ok {
+				sourcesToRemove = append(sourcesToRemove, tfMap["source_arn"].(string))
+			}
+		}
+
+		if len(sourcesToAdd) > 0 {
+			input := &mediaconnect.AddFlowSourcesInput{
+				FlowArn: aws.String(arn),
+				Sources: expandSetSourceRequests(sourcesToAdd),
+			}
+
+			log.Printf("[DEBUG] Adding MediaConnect Flow sources: %s", input)
+			if _, err := conn.AddFlowSources(input); err != nil {
+				return fmt.Errorf("error adding MediaConnect Flow (%s) sources: %w", arn, err)
+			}
+		}
+	}
+
+	if d.HasChange("output") {
+		o, n := d.GetChange("output")
+		os, ns := flowElementsByName(o.([]interface{})), flowElementsByName(n.([]interface{}))
+		var outputsToAdd []interface{}
+
+		for name, tfMap := range ns {
+			old, ok := os[name]
+
+			if !ok {
+				outputsToAdd = append(outputsToAdd, tfMap)
+				continue
+			}
+
+			if reflect.DeepEqual(expandAddOutputRequest(old), expandAddOutputRequest(tfMap)) {
+				continue
+			}
+
+			input := expandUpdateFlowOutputInput(tfMap)
+			input.FlowArn = aws.String(arn)
+			input.OutputArn = aws.String(old["output_arn"].(string))
+
+			log.Printf("[DEBUG] Updating MediaConnect Flow output: %s", input)
+			if _, err := conn.UpdateFlowOutput(input); err != nil {
+				return fmt.Errorf("error updating MediaConnect Flow (%s) output (%s): %w", arn, name, err)
+			}
+		}
+
+		for name, tfMap := range os {
+			if _, ok := ns[name]; ok {
+				continue
+			}
+
+			outputARN := tfMap["output_arn"].(string)
+
+			log.Printf("[DEBUG] Removing MediaConnect Flow output: %s", outputARN)
+			_, err := conn.RemoveFlowOutput(&mediaconnect.RemoveFlowOutputInput{
+				FlowArn:   aws.String(arn),
+				OutputArn: aws.String(outputARN),
+			})
+
+			if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error removing MediaConnect Flow (%s) output (%s): %w", arn, name, err)
+			}
+		}
+
+		if len(outputsToAdd) > 0 {
+			input := &mediaconnect.AddFlowOutputsInput{
+				FlowArn: aws.String(arn),
+				Outputs: expandAddOutputRequests(outputsToAdd),
+			}
+
+			log.Printf("[DEBUG] Adding MediaConnect Flow outputs: %s", input)
+			if _, err := conn.AddFlowOutputs(input); err != nil {
+				return fmt.Errorf("error adding MediaConnect Flow (%s) outputs: %w", arn, err)
+			}
+		}
+	}
+
+	if d.HasChange("entitlement") {
+		o, n := d.GetChange("entitlement")
+		os, ns := flowElementsByName(o.([]interface{})), flowElementsByName(n.([]interface{}))
+		var entitlementsToGrant []interface{}
+		var entitlementsToRevoke []string
+
+		for name, tfMap := range ns {
+			old, ok := os[name]
+
+			if !ok {
+				entitlementsToGrant = append(entitlementsToGrant, tfMap)
+				continue
+			}
+
+			if reflect.DeepEqual(expandGrantEntitlementRequest(old), expandGrantEntitlementRequest(tfMap)) {
+				continue
+			}
+
+			// The data transfer subscriber fee can only be set when the entitlement is granted.
+			if oldFee, newFee := old["data_transfer_subscriber_fee_percent"].(int), tfMap["data_transfer_subscriber_fee_percent"].(int); newFee != 0 && oldFee != newFee {
+				entitlementsToRevoke = append(entitlementsToRevoke, old["entitlement_arn"].(string))
+				entitlementsToGrant = append(entitlementsToGrant, tfMap)
+				continue
+			}
+
+			input := expandUpdateFlowEntitlementInput(tfMap)
+			input.FlowArn = aws.String(arn)
+			input.EntitlementArn = aws.String(old["entitlement_arn"].(string))
+
+			log.Printf("[DEBUG] Updating MediaConnect Flow entitlement: %s", input)
+			if _, err := conn.UpdateFlowEntitlement(input); err != nil {
+				return fmt.Errorf("error updating MediaConnect Flow (%s) entitlement (%s): %w", arn, name, err)
+			}
+		}
+
+		for name, tfMap := range os {
+			if _, ok := ns[name]; !ok {
+				entitlementsToRevoke = append(entitlementsToRevoke, tfMap["entitlement_arn"].(string))
+			}
+		}
+
+		for _, entitlementARN := range entitlementsToRevoke {
+			log.Printf("[DEBUG] Revoking MediaConnect Flow entitlement: %s", entitlementARN)
+			_, err := conn.RevokeFlowEntitlement(&mediaconnect.RevokeFlowEntitlementInput{
+				EntitlementArn: aws.String(entitlementARN),
+				FlowArn:        aws.String(arn),
+			})
+
+			if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error revoking MediaConnect Flow (%s) entitlement (%s): %w", arn, entitlementARN, err)
+			}
+		}
+
+		if len(entitlementsToGrant) > 0 {
+			input := &mediaconnect.GrantFlowEntitlementsInput{
+				Entitlements: expandGrantEntitlementRequests(entitlementsToGrant),
+				FlowArn:      aws.String(arn),
+			}
+
+			log.Printf("[DEBUG] Granting MediaConnect Flow entitlements: %s", input)
+			if _, err := conn.GrantFlowEntitlements(input); err != nil {
+				return fmt.Errorf("error granting MediaConnect Flow (%s) entitlements: %w", arn, err)
+			}
+		}
+	}
+
+	for _, sourceARN := range sourcesToRemove {
+		log.Printf("[DEBUG] Removing MediaConnect Flow source: %s", sourceARN)
+		_, err := conn.RemoveFlowSource(&mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: aws.String(sourceARN),
+		})
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error removing MediaConnect Flow (%s) source (%s): %w", arn, sourceARN, err)
+		}
+	}
+
+	for _, name := range vpcInterfacesToRemove {
+		if err := removeFlowVpcInterface(conn, arn, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeFlowVpcInterface(conn *mediaconnect.MediaConnect, arn, name string) error {
+	log.Printf("[DEBUG] Removing MediaConnect Flow VPC interface: %s", name)
+	_, err := conn.RemoveFlowVpcInterface(&mediaconnect.RemoveFlowVpcInterfaceInput{
+		FlowArn:          aws.String(arn),
+		VpcInterfaceName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing MediaConnect Flow (%s) VPC interface (%s): %w", arn, name, err)
+	}
+
+	return nil
+}
+
+func startFlow(conn *mediaconnect.MediaConnect, arn string) error {
+	log.Printf("[DEBUG] Starting MediaConnect Flow: %s", arn)
+	_, err := conn.StartFlow(&mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := WaitFlowStarted(conn, arn); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(conn *mediaconnect.MediaConnect, arn string) error {
+	log.Printf("[DEBUG] Stopping MediaConnect Flow: %s", arn)
+	_, err := conn.StopFlow(&mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := WaitFlowStopped(conn, arn); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func flowElementsByName(tfList []interface{}) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		m[tfMap["name"].(string)] = tfMap
+	}
+
+	return m
+}
+
+func expandEncryption(tfMap map[string]interface{}) *mediaconnect.Encryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.Encryption{}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateEncryption(tfMap map[string]interface{}) *mediaconnect.UpdateEncryption {
+	apiObject := expandEncryption(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *mediaconnect.FailoverConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["primary_source"].(string); ok && v != "" {
+		apiObject.SourcePriority = &mediaconnect.SourcePriority{
+			PrimarySource: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateFailoverConfig(tfMap map[string]interface{}) *mediaconnect.UpdateFailoverConfig {
+	apiObject := expandFailoverConfig(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateFailoverConfig{
+		FailoverMode:   apiObject.FailoverMode,
+		RecoveryWindow: apiObject.RecoveryWindow,
+		SourcePriority: apiObject.SourcePriority,
+		State:          apiObject.State,
+	}
+}
+
+func expandGrantEntitlementRequest(tfMap map[string]interface{}) *mediaconnect.GrantEntitlementRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.GrantEntitlementRequest{}
+
+	if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+		apiObject.DataTransferSubscriberFeePercent = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+		apiObject.EntitlementStatus = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["subscribers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Subscribers = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandGrantEntitlementRequests(tfList []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var apiObjects []*mediaconnect.GrantEntitlementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandGrantEntitlementRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandUpdateFlowEntitlementInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowEntitlementInput {
+	apiObject := expandGrantEntitlementRequest(tfMap)
+
+	return &mediaconnect.UpdateFlowEntitlementInput{
+		Description:       apiObject.Description,
+		Encryption:        expandUpdateEncryption(flowEncryptionMap(tfMap["encryption"])),
+		EntitlementStatus: apiObject.EntitlementStatus,
+		Subscribers:       apiObject.Subscribers,
+	}
+}
+
+func expandAddOutputRequest(tfMap map[string]interface{}) *mediaconnect.AddOutputRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.AddOutputRequest{}
+
+	if v, ok := tfMap["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAddOutputRequests(tfList []interface{}) []*mediaconnect.AddOutputRequest {
+	var apiObjects []*mediaconnect.AddOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddOutputRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandUpdateFlowOutputInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowOutputInput {
+	apiObject := expandAddOutputRequest(tfMap)
+
+	return &mediaconnect.UpdateFlowOutputInput{
+		CidrAllowList:          apiObject.CidrAllowList,
+		Description:            apiObject.Description,
+		Destination:            apiObject.Destination,
+		Encryption:             expandUpdateEncryption(flowEncryptionMap(tfMap["encryption"])),
+		MaxLatency:             apiObject.MaxLatency,
+		MinLatency:             apiObject.MinLatency,
+		Port:                   apiObject.Port,
+		Protocol:               apiObject.Protocol,
+		RemoteId:               apiObject.RemoteId,
+		SmoothingLatency:       apiObject.SmoothingLatency,
+		StreamId:               apiObject.StreamId,
+		VpcInterfaceAttachment: apiObject.VpcInterfaceAttachment,
+	}
+}
+
+func expandSetSourceRequest(tfMap map[string]interface{}) *mediaconnect.SetSourceRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.SetSourceRequest{}
+
+	if v, ok := tfMap["decryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Decryption = expandEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSetSourceRequests(tfList []interface{}) []*mediaconnect.SetSourceRequest {
+	var apiObjects []*mediaconnect.SetSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandSetSourceRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandUpdateFlowSourceInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowSourceInput {
+	apiObject := expandSetSourceRequest(tfMap)
+
+	return &mediaconnect.UpdateFlowSourceInput{
+		Decryption:       expandUpdateEncryption(flowEncryptionMap(tfMap["decryption"])),
+		Description:      apiObject.Description,
+		EntitlementArn:   apiObject.EntitlementArn,
+		IngestPort:       apiObject.IngestPort,
+		MaxBitrate:       apiObject.MaxBitrate,
+		MaxLatency:       apiObject.MaxLatency,
+		MinLatency:       apiObject.MinLatency,
+		Protocol:         apiObject.Protocol,
+		StreamId:         apiObject.StreamId,
+		VpcInterfaceName: apiObject.VpcInterfaceName,
+		WhitelistCidr:    apiObject.WhitelistCidr,
+	}
+}
+
+func expandVpcInterfaceRequest(tfMap map[string]interface{}) *mediaconnect.VpcInterfaceRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.VpcInterfaceRequest{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+		apiObject.NetworkInterfaceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_id"].(string); ok && v != "" {
+		apiObject.SubnetId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVpcInterfaceRequests(tfList []interface{}) []*mediaconnect.VpcInterfaceRequest {
+	var apiObjects []*mediaconnect.VpcInterfaceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandVpcInterfaceRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+// flowEncryptionMap returns the configuration of a (de)cryption block, or nil if it is not set.
+func flowEncryptionMap(v interface{}) map[string]interface{} {
+	if v, ok := v.([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+
+	return nil
+}
+
+func flattenEncryption(apiObject *mediaconnect.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm":                      aws.StringValue(apiObject.Algorithm),
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(apiObject.DeviceId),
+		"key_type":                       aws.StringValue(apiObject.KeyType),
+		"region":                         aws.StringValue(apiObject.Region),
+		"resource_id":                    aws.StringValue(apiObject.ResourceId),
+		"role_arn":                       aws.StringValue(apiObject.RoleArn),
+		"secret_arn":                     aws.StringValue(apiObject.SecretArn),
+		"url":                            aws.StringValue(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFailoverConfig(apiObject *mediaconnect.FailoverConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   aws.StringValue(apiObject.FailoverMode),
+		"recovery_window": aws.Int64Value(apiObject.RecoveryWindow),
+		"state":           aws.StringValue(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil {
+		tfMap["primary_source"] = aws.StringValue(v.PrimarySource)
+	}
+
+	return tfMap
+}
+
+func flattenEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"data_transfer_subscriber_fee_percent": aws.Int64Value(apiObject.DataTransferSubscriberFeePercent),
+			"description":                          aws.StringValue(apiObject.Description),
+			"encryption":                           flattenEncryption(apiObject.Encryption),
+			"entitlement_arn":                      aws.StringValue(apiObject.EntitlementArn),
+			"entitlement_status":                   aws.StringValue(apiObject.EntitlementStatus),
+			"name":                                 aws.StringValue(apiObject.Name),
+			"subscribers":                          aws.StringValueSlice(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputs(apiObjects []*mediaconnect.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		// Outputs to MediaLive inputs are managed by MediaLive.
+		if apiObject.MediaLiveInputArn != nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description":      aws.StringValue(apiObject.Description),
+			"destination":      aws.StringValue(apiObject.Destination),
+			"encryption":       flattenEncryption(apiObject.Encryption),
+			"listener_address": aws.StringValue(apiObject.ListenerAddress),
+			"name":             aws.StringValue(apiObject.Name),
+			"output_arn":       aws.StringValue(apiObject.OutputArn),
+			"port":             aws.Int64Value(apiObject.Port),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+			tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		if v := apiObject.VpcInterfaceAttachment; v != nil {
+			tfMap["vpc_interface_name"] = aws.StringValue(v.VpcInterfaceName)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSources(apiObjects []*mediaconnect.Source) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"decryption":         flattenEncryption(apiObject.Decryption),
+			"description":        aws.StringValue(apiObject.Description),
+			"entitlement_arn":    aws.StringValue(apiObject.EntitlementArn),
+			"ingest_ip":          aws.StringValue(apiObject.IngestIp),
+			"ingest_port":        aws.Int64Value(apiObject.IngestPort),
+			"name":               aws.StringValue(apiObject.Name),
+			"source_arn":         aws.StringValue(apiObject.SourceArn),
+			"vpc_interface_name": aws.StringValue(apiObject.VpcInterfaceName),
+			"whitelist_cidr":     aws.StringValue(apiObject.WhitelistCidr),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVpcInterfaces(apiObjects []*mediaconnect.VpcInterface) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                   aws.StringValue(apiObject.Name),
+			"network_interface_ids":  aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+			"network_interface_type": aws.StringValue(apiObject.NetworkInterfaceType),
+			"role_arn":               aws.StringValue(apiObject.RoleArn),
+			"security_group_ids":     aws.StringValueSlice(apiObject.SecurityGroupIds),
+			"subnet_id":              aws.StringValue(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,101 @@
+package mediaconnect
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	flowCreatedTimeout = 5 * time.Minute
+	flowUpdatedTimeout = 5 * time.Minute
+	flowDeletedTimeout = 5 * time.Minute
+	flowStartedTimeout = 5 * time.Minute
+	flowStoppedTimeout = 5 * time.Minute
+)
+
+func WaitFlowCreated(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: StatusFlow(conn, arn),
+		Timeout: flowCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFlowUpdated(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby, mediaconnect.StatusActive},
+		Refresh: StatusFlow(conn, arn),
+		Timeout: flowUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFlowDeleted(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting},
+		Target:  []string{},
+		Refresh: StatusFlow(conn, arn),
+		Timeout: flowDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFlowStarted(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStarting, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusActive},
+		Refresh: StatusFlow(conn, arn),
+		Timeout: flowStartedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFlowStopped(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStopping, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: StatusFlow(conn, arn),
+		Timeout: flowStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
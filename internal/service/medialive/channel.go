@@ -0,0 +1,1411 @@
+package medialive
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceChannelCreate,
+		Read:   resourceChannelRead,
+		Update: resourceChannelUpdate,
+		Delete: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      medialive.ChannelClassStandard,
+				ValidateFunc: validation.StringInSlice(medialive.ChannelClass_Values(), false),
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destinations": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_package_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multiplex_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"multiplex_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"program_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encoder_settings": channelEncoderSettingsSchema(),
+			"input_attachments": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatic_input_failover_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_clear_time_msec": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"input_preference": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputPreference_Values(), false),
+									},
+									"secondary_input_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_selectors": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"caption_selectors": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"language_code": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"deblock_filter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputDeblockFilter_Values(), false),
+									},
+									"denoise_filter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputDenoiseFilter_Values(), false),
+									},
+									"filter_strength": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"input_filter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputFilter_Values(), false),
+									},
+									"network_input_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"hls_input_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bandwidth": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"buffer_segments": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"retries": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"retry_interval": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"scte35_source": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(medialive.HlsScte35SourceType_Values(), false),
+															},
+														},
+													},
+												},
+												"server_validation": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(medialive.NetworkInputServerValidation_Values(), false),
+												},
+											},
+										},
+									},
+									"smpte2038_data_preference": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.Smpte2038DataPreference_Values(), false),
+									},
+									"source_end_behavior": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputSourceEndBehavior_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputCodec_Values(), false),
+						},
+						"input_resolution": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputResolution_Values(), false),
+						},
+						"maximum_bitrate": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputMaximumBitrate_Values(), false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(medialive.LogLevel_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"start_channel": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateChannelInput{
+		ChannelClass: aws.String(d.Get("channel_class").(string)),
+		Name:         aws.String(name),
+		RequestId:    aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("destinations"); ok && len(v.([]interface{})) > 0 {
+		input.Destinations = expandOutputDestinations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("encoder_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncoderSettings = expandEncoderSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_attachments"); ok && len(v.([]interface{})) > 0 {
+		input.InputAttachments = expandInputAttachments(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputSpecification = expandInputSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_level"); ok {
+		input.LogLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	output, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Channel (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Id))
+
+	if _, err := WaitChannelCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) create: %w", d.Id(), err)
+	}
+
+	if d.Get("start_channel").(bool) {
+		if err := startChannel(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceChannelRead(d, meta)
+}
+
+func resourceChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindChannelByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("channel_class", output.ChannelClass)
+	d.Set("channel_id", output.Id)
+	if err := d.Set("destinations", flattenOutputDestinations(output.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %w", err)
+	}
+	if output.EncoderSettings != nil {
+		if err := d.Set("encoder_settings", []interface{}{flattenEncoderSettings(output.EncoderSettings)}); err != nil {
+			return fmt.Errorf("error setting encoder_settings: %w", err)
+		}
+	} else {
+		d.Set("encoder_settings", nil)
+	}
+	if err := d.Set("input_attachments", flattenInputAttachments(output.InputAttachments)); err != nil {
+		return fmt.Errorf("error setting input_attachments: %w", err)
+	}
+	if output.InputSpecification != nil {
+		if err := d.Set("input_specification", []interface{}{flattenInputSpecification(output.InputSpecification)}); err != nil {
+			return fmt.Errorf("error setting input_specification: %w", err)
+		}
+	} else {
+		d.Set("input_specification", nil)
+	}
+	d.Set("log_level", output.LogLevel)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("start_channel", aws.StringValue(output.State) == medialive.ChannelStateRunning)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChangesExcept("start_channel", "tags", "tags_all") {
+		output, err := FindChannelByID(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+		}
+
+		// A running channel cannot be updated, so stop it first. It is restarted below if requested.
+		if aws.StringValue(output.State) == medialive.ChannelStateRunning {
+			if err := stopChannel(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		input := &medialive.UpdateChannelInput{
+			ChannelId: aws.String(d.Id()),
+			Name:      aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("destinations"); ok && len(v.([]interface{})) > 0 {
+			input.Destinations = expandOutputDestinations(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("encoder_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.EncoderSettings = expandEncoderSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("input_attachments"); ok && len(v.([]interface{})) > 0 {
+			input.InputAttachments = expandInputAttachments(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InputSpecification = expandInputSpecification(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("log_level"); ok {
+			input.LogLevel = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		_, err = conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitChannelUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Channel (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		output, err := FindChannelByID(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+		}
+
+		running := aws.StringValue(output.State) == medialive.ChannelStateRunning
+
+		if start := d.Get("start_channel").(bool); start && !running {
+			if err := startChannel(conn, d.Id()); err != nil {
+				return err
+			}
+		} else if !start && running {
+			if err := stopChannel(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(d, meta)
+}
+
+func resourceChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	output, err := FindChannelByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	if aws.StringValue(output.State) == medialive.ChannelStateRunning {
+		if err := stopChannel(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err = conn.DeleteChannel(&medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitChannelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startChannel(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Starting MediaLive Channel: %s", id)
+	_, err := conn.StartChannel(&medialive.StartChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Channel (%s): %w", id, err)
+	}
+
+	if _, err := WaitChannelStarted(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func stopChannel(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Stopping MediaLive Channel: %s", id)
+	_, err := conn.StopChannel(&medialive.StopChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Channel (%s): %w", id, err)
+	}
+
+	if _, err := WaitChannelStopped(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandOutputDestination(tfMap map[string]interface{}) *medialive.OutputDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.OutputDestination{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["media_package_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaPackageSettings = expandMediaPackageOutputDestinationSettingsList(v)
+	}
+
+	if v, ok := tfMap["multiplex_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MultiplexSettings = expandMultiplexProgramChannelDestinationSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Settings = expandOutputDestinationSettingsList(v)
+	}
+
+	return apiObject
+}
+
+func expandOutputDestinations(tfList []interface{}) []*medialive.OutputDestination {
+	apiObjects := []*medialive.OutputDestination{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandOutputDestination(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaPackageOutputDestinationSettings(tfMap map[string]interface{}) *medialive.MediaPackageOutputDestinationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.MediaPackageOutputDestinationSettings{}
+
+	if v, ok := tfMap["channel_id"].(string); ok && v != "" {
+		apiObject.ChannelId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageOutputDestinationSettingsList(tfList []interface{}) []*medialive.MediaPackageOutputDestinationSettings {
+	apiObjects := []*medialive.MediaPackageOutputDestinationSettings{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandMediaPackageOutputDestinationSettings(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMultiplexProgramChannelDestinationSettings(tfMap map[string]interface{}) *medialive.MultiplexProgramChannelDestinationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.MultiplexProgramChannelDestinationSettings{}
+
+	if v, ok := tfMap["multiplex_id"].(string); ok && v != "" {
+		apiObject.MultiplexId = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_name"].(string); ok && v != "" {
+		apiObject.ProgramName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOutputDestinationSettings(tfMap map[string]interface{}) *medialive.OutputDestinationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.OutputDestinationSettings{}
+
+	if v, ok := tfMap["password_param"].(string); ok && v != "" {
+		apiObject.PasswordParam = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+		apiObject.StreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	if v, ok := tfMap["username"].(string); ok && v != "" {
+		apiObject.Username = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOutputDestinationSettingsList(tfList []interface{}) []*medialive.OutputDestinationSettings {
+	apiObjects := []*medialive.OutputDestinationSettings{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandOutputDestinationSettings(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInputAttachment(tfMap map[string]interface{}) *medialive.InputAttachment {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.InputAttachment{}
+
+	if v, ok := tfMap["automatic_input_failover_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AutomaticInputFailoverSettings = expandAutomaticInputFailoverSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input_attachment_name"].(string); ok && v != "" {
+		apiObject.InputAttachmentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_id"].(string); ok && v != "" {
+		apiObject.InputId = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InputSettings = expandInputSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInputAttachments(tfList []interface{}) []*medialive.InputAttachment {
+	apiObjects := []*medialive.InputAttachment{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandInputAttachment(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAutomaticInputFailoverSettings(tfMap map[string]interface{}) *medialive.AutomaticInputFailoverSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.AutomaticInputFailoverSettings{}
+
+	if v, ok := tfMap["error_clear_time_msec"].(int); ok && v != 0 {
+		apiObject.ErrorClearTimeMsec = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["input_preference"].(string); ok && v != "" {
+		apiObject.InputPreference = aws.String(v)
+	}
+
+	if v, ok := tfMap["secondary_input_id"].(string); ok && v != "" {
+		apiObject.SecondaryInputId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInputSettings(tfMap map[string]interface{}) *medialive.InputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.InputSettings{}
+
+	if v, ok := tfMap["audio_selectors"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AudioSelectors = expandAudioSelectors(v)
+	}
+
+	if v, ok := tfMap["caption_selectors"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CaptionSelectors = expandCaptionSelectors(v)
+	}
+
+	if v, ok := tfMap["deblock_filter"].(string); ok && v != "" {
+		apiObject.DeblockFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["denoise_filter"].(string); ok && v != "" {
+		apiObject.DenoiseFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter_strength"].(int); ok && v != 0 {
+		apiObject.FilterStrength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["input_filter"].(string); ok && v != "" {
+		apiObject.InputFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["network_input_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkInputSettings = expandNetworkInputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["smpte2038_data_preference"].(string); ok && v != "" {
+		apiObject.Smpte2038DataPreference = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_end_behavior"].(string); ok && v != "" {
+		apiObject.SourceEndBehavior = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioSelector(tfMap map[string]interface{}) *medialive.AudioSelector {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.AudioSelector{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioSelectors(tfList []interface{}) []*medialive.AudioSelector {
+	apiObjects := []*medialive.AudioSelector{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAudioSelector(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCaptionSelector(tfMap map[string]interface{}) *medialive.CaptionSelector {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.CaptionSelector{}
+
+	if v, ok := tfMap["language_code"].(string); ok && v != "" {
+		apiObject.LanguageCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCaptionSelectors(tfList []interface{}) []*medialive.CaptionSelector {
+	apiObjects := []*medialive.CaptionSelector{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCaptionSelector(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNetworkInputSettings(tfMap map[string]interface{}) *medialive.NetworkInputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.NetworkInputSettings{}
+
+	if v, ok := tfMap["hls_input_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HlsInputSettings = expandHlsInputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["server_validation"].(string); ok && v != "" {
+		apiObject.ServerValidation = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsInputSettings(tfMap map[string]interface{}) *medialive.HlsInputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.HlsInputSettings{}
+
+	if v, ok := tfMap["bandwidth"].(int); ok && v != 0 {
+		apiObject.Bandwidth = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["buffer_segments"].(int); ok && v != 0 {
+		apiObject.BufferSegments = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retry_interval"].(int); ok && v != 0 {
+		apiObject.RetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scte35_source"].(string); ok && v != "" {
+		apiObject.Scte35Source = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInputSpecification(tfMap map[string]interface{}) *medialive.InputSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.InputSpecification{}
+
+	if v, ok := tfMap["codec"].(string); ok && v != "" {
+		apiObject.Codec = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_resolution"].(string); ok && v != "" {
+		apiObject.Resolution = aws.String(v)
+	}
+
+	if v, ok := tfMap["maximum_bitrate"].(string); ok && v != "" {
+		apiObject.MaximumBitrate = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputDestination(apiObject *medialive.OutputDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Id; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MediaPackageSettings; v != nil {
+		tfMap["media_package_settings"] = flattenMediaPackageOutputDestinationSettingsList(v)
+	}
+
+	if v := apiObject.MultiplexSettings; v != nil {
+		tfMap["multiplex_settings"] = []interface{}{flattenMultiplexProgramChannelDestinationSettings(v)}
+	}
+
+	if v := apiObject.Settings; v != nil {
+		tfMap["settings"] = flattenOutputDestinationSettingsList(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputDestinations(apiObjects []*medialive.OutputDestination) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenOutputDestination(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenMediaPackageOutputDestinationSettings(apiObject *medialive.MediaPackageOutputDestinationSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ChannelId; v != nil {
+		tfMap["channel_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenMediaPackageOutputDestinationSettingsList(apiObjects []*medialive.MediaPackageOutputDestinationSettings) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenMediaPackageOutputDestinationSettings(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenMultiplexProgramChannelDestinationSettings(apiObject *medialive.MultiplexProgramChannelDestinationSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MultiplexId; v != nil {
+		tfMap["multiplex_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ProgramName; v != nil {
+		tfMap["program_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputDestinationSettings(apiObject *medialive.OutputDestinationSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PasswordParam; v != nil {
+		tfMap["password_param"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreamName; v != nil {
+		tfMap["stream_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Url; v != nil {
+		tfMap["url"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Username; v != nil {
+		tfMap["username"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputDestinationSettingsList(apiObjects []*medialive.OutputDestinationSettings) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenOutputDestinationSettings(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenInputAttachment(apiObject *medialive.InputAttachment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutomaticInputFailoverSettings; v != nil {
+		tfMap["automatic_input_failover_settings"] = []interface{}{flattenAutomaticInputFailoverSettings(v)}
+	}
+
+	if v := apiObject.InputAttachmentName; v != nil {
+		tfMap["input_attachment_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InputId; v != nil {
+		tfMap["input_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InputSettings; v != nil {
+		tfMap["input_settings"] = []interface{}{flattenInputSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenInputAttachments(apiObjects []*medialive.InputAttachment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenInputAttachment(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenAutomaticInputFailoverSettings(apiObject *medialive.AutomaticInputFailoverSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ErrorClearTimeMsec; v != nil {
+		tfMap["error_clear_time_msec"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.InputPreference; v != nil {
+		tfMap["input_preference"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SecondaryInputId; v != nil {
+		tfMap["secondary_input_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenInputSettings(apiObject *medialive.InputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioSelectors; v != nil {
+		tfMap["audio_selectors"] = flattenAudioSelectors(v)
+	}
+
+	if v := apiObject.CaptionSelectors; v != nil {
+		tfMap["caption_selectors"] = flattenCaptionSelectors(v)
+	}
+
+	if v := apiObject.DeblockFilter; v != nil {
+		tfMap["deblock_filter"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DenoiseFilter; v != nil {
+		tfMap["denoise_filter"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FilterStrength; v != nil {
+		tfMap["filter_strength"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.InputFilter; v != nil {
+		tfMap["input_filter"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NetworkInputSettings; v != nil {
+		tfMap["network_input_settings"] = []interface{}{flattenNetworkInputSettings(v)}
+	}
+
+	if v := apiObject.Smpte2038DataPreference; v != nil {
+		tfMap["smpte2038_data_preference"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SourceEndBehavior; v != nil {
+		tfMap["source_end_behavior"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAudioSelector(apiObject *medialive.AudioSelector) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAudioSelectors(apiObjects []*medialive.AudioSelector) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAudioSelector(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCaptionSelector(apiObject *medialive.CaptionSelector) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LanguageCode; v != nil {
+		tfMap["language_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCaptionSelectors(apiObjects []*medialive.CaptionSelector) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCaptionSelector(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenNetworkInputSettings(apiObject *medialive.NetworkInputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HlsInputSettings; v != nil {
+		tfMap["hls_input_settings"] = []interface{}{flattenHlsInputSettings(v)}
+	}
+
+	if v := apiObject.ServerValidation; v != nil {
+		tfMap["server_validation"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenHlsInputSettings(apiObject *medialive.HlsInputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bandwidth; v != nil {
+		tfMap["bandwidth"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.BufferSegments; v != nil {
+		tfMap["buffer_segments"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Retries; v != nil {
+		tfMap["retries"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RetryInterval; v != nil {
+		tfMap["retry_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Scte35Source; v != nil {
+		tfMap["scte35_source"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenInputSpecification(apiObject *medialive.InputSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Codec; v != nil {
+		tfMap["codec"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Resolution; v != nil {
+		tfMap["input_resolution"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MaximumBitrate; v != nil {
+		tfMap["maximum_bitrate"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,3611 @@
+package medialive
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func channelEncoderSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_descriptions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"audio_selector_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"audio_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.AudioType_Values(), false),
+							},
+							"audio_type_control": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.AudioDescriptionAudioTypeControl_Values(), false),
+							},
+							"codec_settings": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"aac_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"bitrate": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"coding_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacCodingMode_Values(), false),
+													},
+													"input_type": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacInputType_Values(), false),
+													},
+													"profile": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacProfile_Values(), false),
+													},
+													"rate_control_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacRateControlMode_Values(), false),
+													},
+													"raw_format": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacRawFormat_Values(), false),
+													},
+													"sample_rate": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"spec": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacSpec_Values(), false),
+													},
+													"vbr_quality": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacVbrQuality_Values(), false),
+													},
+												},
+											},
+										},
+										"ac3_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"bitrate": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"bitstream_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3BitstreamMode_Values(), false),
+													},
+													"coding_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3CodingMode_Values(), false),
+													},
+													"dialnorm": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"drc_profile": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3DrcProfile_Values(), false),
+													},
+													"lfe_filter": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3LfeFilter_Values(), false),
+													},
+													"metadata_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3MetadataControl_Values(), false),
+													},
+												},
+											},
+										},
+										"pass_through_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{},
+											},
+										},
+									},
+								},
+							},
+							"language_code": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							"language_code_control": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.AudioDescriptionLanguageCodeControl_Values(), false),
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"stream_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"output_groups": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							"output_group_settings": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"archive_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": outputLocationRefSchema(true),
+													"rollover_interval": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+										"frame_capture_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": outputLocationRefSchema(true),
+												},
+											},
+										},
+										"hls_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": outputLocationRefSchema(true),
+													"directory_structure": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsDirectoryStructure_Values(), false),
+													},
+													"input_loss_action": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.InputLossActionForHlsOut_Values(), false),
+													},
+													"keep_segments": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"manifest_duration_format": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsManifestDurationFormat_Values(), false),
+													},
+													"min_segment_length": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsMode_Values(), false),
+													},
+													"output_selection": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsOutputSelection_Values(), false),
+													},
+													"program_date_time": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsProgramDateTime_Values(), false),
+													},
+													"program_date_time_period": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"segment_length": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"segmentation_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsSegmentationMode_Values(), false),
+													},
+													"stream_inf_resolution": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsStreamInfResolution_Values(), false),
+													},
+													"ts_file_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsTsFileMode_Values(), false),
+													},
+												},
+											},
+										},
+										"media_package_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": outputLocationRefSchema(true),
+												},
+											},
+										},
+										"multiplex_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{},
+											},
+										},
+										"rtmp_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"authentication_scheme": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AuthenticationScheme_Values(), false),
+													},
+													"cache_full_behavior": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.RtmpCacheFullBehavior_Values(), false),
+													},
+													"cache_length": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"caption_data": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.RtmpCaptionData_Values(), false),
+													},
+													"input_loss_action": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.InputLossActionForRtmpOut_Values(), false),
+													},
+													"restart_delay": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+										"udp_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"input_loss_action": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.InputLossActionForUdpOut_Values(), false),
+													},
+													"timed_metadata_id3_frame": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.UdpTimedMetadataId3Frame_Values(), false),
+													},
+													"timed_metadata_id3_period": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"outputs": {
+								Type:     schema.TypeList,
+								Required: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"audio_description_names": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"caption_description_names": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"output_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+										},
+										"output_settings": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"archive_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Computed: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"container_settings": {
+																	Type:     schema.TypeList,
+																	Required: true,
+																	MaxItems: 1,
+																	Elem: &schema.Resource{
+																		Schema: map[string]*schema.Schema{
+																			"m2ts_settings": m2tsSettingsSchema(false),
+																		},
+																	},
+																},
+																"extension": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+																"name_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
+													"frame_capture_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Computed: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
+													"hls_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Computed: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"h265_packaging_type": {
+																	Type:         schema.TypeString,
+																	Optional:     true,
+																	Computed:     true,
+																	ValidateFunc: validation.StringInSlice(medialive.HlsH265PackagingType_Values(), false),
+																},
+																"hls_settings": {
+																	Type:     schema.TypeList,
+																	Required: true,
+																	MaxItems: 1,
+																	Elem: &schema.Resource{
+																		Schema: map[string]*schema.Schema{
+																			"standard_hls_settings": {
+																				Type:     schema.TypeList,
+																				Required: true,
+																				MaxItems: 1,
+																				Elem: &schema.Resource{
+																					Schema: map[string]*schema.Schema{
+																						"audio_rendition_sets": {
+																							Type:     schema.TypeString,
+																							Optional: true,
+																							Computed: true,
+																						},
+																						"m3u8_settings": {
+																							Type:     schema.TypeList,
+																							Required: true,
+																							MaxItems: 1,
+																							Elem: &schema.Resource{
+																								Schema: map[string]*schema.Schema{
+																									"audio_frames_per_pes": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"audio_pids": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"ecm_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"nielsen_id3_behavior": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8NielsenId3Behavior_Values(), false),
+																									},
+																									"pat_interval": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"pcr_control": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8PcrControl_Values(), false),
+																									},
+																									"pcr_period": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"pcr_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"pmt_interval": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"pmt_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"program_num": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"scte35_behavior": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8Scte35Behavior_Values(), false),
+																									},
+																									"scte35_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"timed_metadata_behavior": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8TimedMetadataBehavior_Values(), false),
+																									},
+																									"timed_metadata_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"transport_stream_id": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"video_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+																"name_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+																"segment_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
+													"media_package_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{},
+														},
+													},
+													"multiplex_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Computed: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"destination": outputLocationRefSchema(true),
+															},
+														},
+													},
+													"rtmp_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Computed: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"certificate_mode": {
+																	Type:         schema.TypeString,
+																	Optional:     true,
+																	Computed:     true,
+																	ValidateFunc: validation.StringInSlice(medialive.RtmpOutputCertificateMode_Values(), false),
+																},
+																"connection_retry_interval": {
+																	Type:     schema.TypeInt,
+																	Optional: true,
+																	Computed: true,
+																},
+																"destination": outputLocationRefSchema(true),
+																"num_retries": {
+																	Type:     schema.TypeInt,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
+													"udp_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Computed: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"buffer_msec": {
+																	Type:     schema.TypeInt,
+																	Optional: true,
+																	Computed: true,
+																},
+																"container_settings": {
+																	Type:     schema.TypeList,
+																	Required: true,
+																	MaxItems: 1,
+																	Elem: &schema.Resource{
+																		Schema: map[string]*schema.Schema{
+																			"m2ts_settings": m2tsSettingsSchema(false),
+																		},
+																	},
+																},
+																"destination": outputLocationRefSchema(true),
+															},
+														},
+													},
+												},
+											},
+										},
+										"video_description_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"timecode_config": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"source": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(medialive.TimecodeConfigSource_Values(), false),
+							},
+							"sync_threshold": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"video_descriptions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"codec_settings": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"frame_capture_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"capture_interval": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"capture_interval_units": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.FrameCaptureIntervalUnit_Values(), false),
+													},
+												},
+											},
+										},
+										"h264_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"adaptive_quantization": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264AdaptiveQuantization_Values(), false),
+													},
+													"afd_signaling": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AfdSignaling_Values(), false),
+													},
+													"bitrate": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"buf_fill_pct": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"buf_size": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"color_metadata": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264ColorMetadata_Values(), false),
+													},
+													"entropy_encoding": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264EntropyEncoding_Values(), false),
+													},
+													"fixed_afd": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.FixedAfd_Values(), false),
+													},
+													"flicker_aq": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264FlickerAq_Values(), false),
+													},
+													"force_field_pictures": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264ForceFieldPictures_Values(), false),
+													},
+													"framerate_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264FramerateControl_Values(), false),
+													},
+													"framerate_denominator": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"framerate_numerator": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"gop_b_reference": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264GopBReference_Values(), false),
+													},
+													"gop_closed_cadence": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"gop_num_b_frames": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"gop_size": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"gop_size_units": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264GopSizeUnits_Values(), false),
+													},
+													"level": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264Level_Values(), false),
+													},
+													"look_ahead_rate_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264LookAheadRateControl_Values(), false),
+													},
+													"max_bitrate": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"min_i_interval": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"num_ref_frames": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"par_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264ParControl_Values(), false),
+													},
+													"par_denominator": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"par_numerator": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"profile": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264Profile_Values(), false),
+													},
+													"quality_level": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264QualityLevel_Values(), false),
+													},
+													"qvbr_quality_level": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"rate_control_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264RateControlMode_Values(), false),
+													},
+													"scan_type": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264ScanType_Values(), false),
+													},
+													"scene_change_detect": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264SceneChangeDetect_Values(), false),
+													},
+													"slices": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"softness": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"spatial_aq": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264SpatialAq_Values(), false),
+													},
+													"subgop_length": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264SubGopLength_Values(), false),
+													},
+													"syntax": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264Syntax_Values(), false),
+													},
+													"temporal_aq": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264TemporalAq_Values(), false),
+													},
+													"timecode_insertion": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264TimecodeInsertionBehavior_Values(), false),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"height": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"respond_to_afd": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.VideoDescriptionRespondToAfd_Values(), false),
+							},
+							"scaling_behavior": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.VideoDescriptionScalingBehavior_Values(), false),
+							},
+							"sharpness": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+							},
+							"width": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func outputLocationRefSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		Computed: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_ref_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func m2tsSettingsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		Computed: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"absent_input_audio_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAbsentInputAudioBehavior_Values(), false),
+				},
+				"arib": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsArib_Values(), false),
+				},
+				"audio_buffer_model": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAudioBufferModel_Values(), false),
+				},
+				"audio_frames_per_pes": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_stream_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAudioStreamType_Values(), false),
+				},
+				"bitrate": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"buffer_model": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsBufferModel_Values(), false),
+				},
+				"cc_descriptor": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsCcDescriptor_Values(), false),
+				},
+				"ebif": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsEbifControl_Values(), false),
+				},
+				"es_rate_in_pes": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsEsRateInPes_Values(), false),
+				},
+				"pcr_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsPcrControl_Values(), false),
+				},
+				"pcr_period": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pcr_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"pmt_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pmt_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"program_num": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"rate_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsRateMode_Values(), false),
+				},
+				"scte35_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsScte35Control_Values(), false),
+				},
+				"scte35_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"segmentation_markers": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsSegmentationMarkers_Values(), false),
+				},
+				"segmentation_style": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsSegmentationStyle_Values(), false),
+				},
+				"segmentation_time": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Computed: true,
+				},
+				"timed_metadata_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsTimedMetadataBehavior_Values(), false),
+				},
+				"transport_stream_id": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"video_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandEncoderSettings(tfMap map[string]interface{}) *medialive.EncoderSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.EncoderSettings{}
+
+	if v, ok := tfMap["audio_descriptions"].([]interface{}); ok {
+		apiObject.AudioDescriptions = expandAudioDescriptions(v)
+	}
+
+	if v, ok := tfMap["output_groups"].([]interface{}); ok {
+		apiObject.OutputGroups = expandOutputGroups(v)
+	}
+
+	if v, ok := tfMap["timecode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TimecodeConfig = expandTimecodeConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["video_descriptions"].([]interface{}); ok {
+		apiObject.VideoDescriptions = expandVideoDescriptions(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioDescription(tfMap map[string]interface{}) *medialive.AudioDescription {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.AudioDescription{}
+
+	if v, ok := tfMap["audio_selector_name"].(string); ok && v != "" {
+		apiObject.AudioSelectorName = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_type"].(string); ok && v != "" {
+		apiObject.AudioType = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_type_control"].(string); ok && v != "" {
+		apiObject.AudioTypeControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["codec_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CodecSettings = expandAudioCodecSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["language_code"].(string); ok && v != "" {
+		apiObject.LanguageCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["language_code_control"].(string); ok && v != "" {
+		apiObject.LanguageCodeControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+		apiObject.StreamName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioDescriptions(tfList []interface{}) []*medialive.AudioDescription {
+	apiObjects := []*medialive.AudioDescription{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAudioDescription(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAudioCodecSettings(tfMap map[string]interface{}) *medialive.AudioCodecSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.AudioCodecSettings{}
+
+	if v, ok := tfMap["aac_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AacSettings = expandAacSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ac3_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Ac3Settings = expandAc3Settings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["pass_through_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PassThroughSettings = &medialive.PassThroughSettings{}
+	}
+
+	return apiObject
+}
+
+func expandAacSettings(tfMap map[string]interface{}) *medialive.AacSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.AacSettings{}
+
+	if v, ok := tfMap["bitrate"].(float64); ok && v != 0 {
+		apiObject.Bitrate = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["coding_mode"].(string); ok && v != "" {
+		apiObject.CodingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_type"].(string); ok && v != "" {
+		apiObject.InputType = aws.String(v)
+	}
+
+	if v, ok := tfMap["profile"].(string); ok && v != "" {
+		apiObject.Profile = aws.String(v)
+	}
+
+	if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+		apiObject.RateControlMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_format"].(string); ok && v != "" {
+		apiObject.RawFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["sample_rate"].(float64); ok && v != 0 {
+		apiObject.SampleRate = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["spec"].(string); ok && v != "" {
+		apiObject.Spec = aws.String(v)
+	}
+
+	if v, ok := tfMap["vbr_quality"].(string); ok && v != "" {
+		apiObject.VbrQuality = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAc3Settings(tfMap map[string]interface{}) *medialive.Ac3Settings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.Ac3Settings{}
+
+	if v, ok := tfMap["bitrate"].(float64); ok && v != 0 {
+		apiObject.Bitrate = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["bitstream_mode"].(string); ok && v != "" {
+		apiObject.BitstreamMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["coding_mode"].(string); ok && v != "" {
+		apiObject.CodingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["dialnorm"].(int); ok && v != 0 {
+		apiObject.Dialnorm = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["drc_profile"].(string); ok && v != "" {
+		apiObject.DrcProfile = aws.String(v)
+	}
+
+	if v, ok := tfMap["lfe_filter"].(string); ok && v != "" {
+		apiObject.LfeFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_control"].(string); ok && v != "" {
+		apiObject.MetadataControl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOutputGroup(tfMap map[string]interface{}) *medialive.OutputGroup {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.OutputGroup{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OutputGroupSettings = expandOutputGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["outputs"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Outputs = expandOutputs(v)
+	}
+
+	return apiObject
+}
+
+func expandOutputGroups(tfList []interface{}) []*medialive.OutputGroup {
+	apiObjects := []*medialive.OutputGroup{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandOutputGroup(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputGroupSettings(tfMap map[string]interface{}) *medialive.OutputGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.OutputGroupSettings{}
+
+	if v, ok := tfMap["archive_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ArchiveGroupSettings = expandArchiveGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["frame_capture_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FrameCaptureGroupSettings = expandFrameCaptureGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["hls_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HlsGroupSettings = expandHlsGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["media_package_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MediaPackageGroupSettings = expandMediaPackageGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["multiplex_group_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MultiplexGroupSettings = &medialive.MultiplexGroupSettings{}
+	}
+
+	if v, ok := tfMap["rtmp_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RtmpGroupSettings = expandRtmpGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["udp_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UdpGroupSettings = expandUdpGroupSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandArchiveGroupSettings(tfMap map[string]interface{}) *medialive.ArchiveGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveGroupSettings{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rollover_interval"].(int); ok && v != 0 {
+		apiObject.RolloverInterval = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandOutputLocationRef(tfMap map[string]interface{}) *medialive.OutputLocationRef {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.OutputLocationRef{}
+
+	if v, ok := tfMap["destination_ref_id"].(string); ok && v != "" {
+		apiObject.DestinationRefId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureGroupSettings(tfMap map[string]interface{}) *medialive.FrameCaptureGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureGroupSettings{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHlsGroupSettings(tfMap map[string]interface{}) *medialive.HlsGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.HlsGroupSettings{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["directory_structure"].(string); ok && v != "" {
+		apiObject.DirectoryStructure = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["keep_segments"].(int); ok && v != 0 {
+		apiObject.KeepSegments = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["manifest_duration_format"].(string); ok && v != "" {
+		apiObject.ManifestDurationFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["min_segment_length"].(int); ok && v != 0 {
+		apiObject.MinSegmentLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_selection"].(string); ok && v != "" {
+		apiObject.OutputSelection = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_date_time"].(string); ok && v != "" {
+		apiObject.ProgramDateTime = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_date_time_period"].(int); ok && v != 0 {
+		apiObject.ProgramDateTimePeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_length"].(int); ok && v != 0 {
+		apiObject.SegmentLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segmentation_mode"].(string); ok && v != "" {
+		apiObject.SegmentationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_inf_resolution"].(string); ok && v != "" {
+		apiObject.StreamInfResolution = aws.String(v)
+	}
+
+	if v, ok := tfMap["ts_file_mode"].(string); ok && v != "" {
+		apiObject.TsFileMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageGroupSettings(tfMap map[string]interface{}) *medialive.MediaPackageGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.MediaPackageGroupSettings{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandRtmpGroupSettings(tfMap map[string]interface{}) *medialive.RtmpGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.RtmpGroupSettings{}
+
+	if v, ok := tfMap["authentication_scheme"].(string); ok && v != "" {
+		apiObject.AuthenticationScheme = aws.String(v)
+	}
+
+	if v, ok := tfMap["cache_full_behavior"].(string); ok && v != "" {
+		apiObject.CacheFullBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["cache_length"].(int); ok && v != 0 {
+		apiObject.CacheLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["caption_data"].(string); ok && v != "" {
+		apiObject.CaptionData = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandUdpGroupSettings(tfMap map[string]interface{}) *medialive.UdpGroupSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.UdpGroupSettings{}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_id3_frame"].(string); ok && v != "" {
+		apiObject.TimedMetadataId3Frame = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_id3_period"].(int); ok && v != 0 {
+		apiObject.TimedMetadataId3Period = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandOutput(tfMap map[string]interface{}) *medialive.Output {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.Output{}
+
+	if v, ok := tfMap["audio_description_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AudioDescriptionNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["caption_description_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CaptionDescriptionNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["output_name"].(string); ok && v != "" {
+		apiObject.OutputName = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OutputSettings = expandOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["video_description_name"].(string); ok && v != "" {
+		apiObject.VideoDescriptionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOutputs(tfList []interface{}) []*medialive.Output {
+	apiObjects := []*medialive.Output{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandOutput(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputSettings(tfMap map[string]interface{}) *medialive.OutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.OutputSettings{}
+
+	if v, ok := tfMap["archive_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ArchiveOutputSettings = expandArchiveOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["frame_capture_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FrameCaptureOutputSettings = expandFrameCaptureOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["hls_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HlsOutputSettings = expandHlsOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["media_package_output_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaPackageOutputSettings = &medialive.MediaPackageOutputSettings{}
+	}
+
+	if v, ok := tfMap["multiplex_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MultiplexOutputSettings = expandMultiplexOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rtmp_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RtmpOutputSettings = expandRtmpOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["udp_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UdpOutputSettings = expandUdpOutputSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandArchiveOutputSettings(tfMap map[string]interface{}) *medialive.ArchiveOutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveOutputSettings{}
+
+	if v, ok := tfMap["container_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerSettings = expandArchiveContainerSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["extension"].(string); ok && v != "" {
+		apiObject.Extension = aws.String(v)
+	}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandArchiveContainerSettings(tfMap map[string]interface{}) *medialive.ArchiveContainerSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveContainerSettings{}
+
+	if v, ok := tfMap["m2ts_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.M2tsSettings = expandM2tsSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandM2tsSettings(tfMap map[string]interface{}) *medialive.M2tsSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.M2tsSettings{}
+
+	if v, ok := tfMap["absent_input_audio_behavior"].(string); ok && v != "" {
+		apiObject.AbsentInputAudioBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["arib"].(string); ok && v != "" {
+		apiObject.Arib = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_buffer_model"].(string); ok && v != "" {
+		apiObject.AudioBufferModel = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_frames_per_pes"].(int); ok && v != 0 {
+		apiObject.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["audio_pids"].(string); ok && v != "" {
+		apiObject.AudioPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_stream_type"].(string); ok && v != "" {
+		apiObject.AudioStreamType = aws.String(v)
+	}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["buffer_model"].(string); ok && v != "" {
+		apiObject.BufferModel = aws.String(v)
+	}
+
+	if v, ok := tfMap["cc_descriptor"].(string); ok && v != "" {
+		apiObject.CcDescriptor = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebif"].(string); ok && v != "" {
+		apiObject.Ebif = aws.String(v)
+	}
+
+	if v, ok := tfMap["es_rate_in_pes"].(string); ok && v != "" {
+		apiObject.EsRateInPes = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_control"].(string); ok && v != "" {
+		apiObject.PcrControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_period"].(int); ok && v != 0 {
+		apiObject.PcrPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_pid"].(string); ok && v != "" {
+		apiObject.PcrPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["pmt_interval"].(int); ok && v != 0 {
+		apiObject.PmtInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pmt_pid"].(string); ok && v != "" {
+		apiObject.PmtPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_num"].(int); ok && v != 0 {
+		apiObject.ProgramNum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rate_mode"].(string); ok && v != "" {
+		apiObject.RateMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_control"].(string); ok && v != "" {
+		apiObject.Scte35Control = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_pid"].(string); ok && v != "" {
+		apiObject.Scte35Pid = aws.String(v)
+	}
+
+	if v, ok := tfMap["segmentation_markers"].(string); ok && v != "" {
+		apiObject.SegmentationMarkers = aws.String(v)
+	}
+
+	if v, ok := tfMap["segmentation_style"].(string); ok && v != "" {
+		apiObject.SegmentationStyle = aws.String(v)
+	}
+
+	if v, ok := tfMap["segmentation_time"].(float64); ok && v != 0 {
+		apiObject.SegmentationTime = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_behavior"].(string); ok && v != "" {
+		apiObject.TimedMetadataBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["transport_stream_id"].(int); ok && v != 0 {
+		apiObject.TransportStreamId = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["video_pid"].(string); ok && v != "" {
+		apiObject.VideoPid = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureOutputSettings(tfMap map[string]interface{}) *medialive.FrameCaptureOutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureOutputSettings{}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsOutputSettings(tfMap map[string]interface{}) *medialive.HlsOutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.HlsOutputSettings{}
+
+	if v, ok := tfMap["h265_packaging_type"].(string); ok && v != "" {
+		apiObject.H265PackagingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hls_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HlsSettings = expandHlsSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["segment_modifier"].(string); ok && v != "" {
+		apiObject.SegmentModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsSettings(tfMap map[string]interface{}) *medialive.HlsSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.HlsSettings{}
+
+	if v, ok := tfMap["standard_hls_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StandardHlsSettings = expandStandardHlsSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStandardHlsSettings(tfMap map[string]interface{}) *medialive.StandardHlsSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.StandardHlsSettings{}
+
+	if v, ok := tfMap["audio_rendition_sets"].(string); ok && v != "" {
+		apiObject.AudioRenditionSets = aws.String(v)
+	}
+
+	if v, ok := tfMap["m3u8_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.M3u8Settings = expandM3u8Settings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandM3u8Settings(tfMap map[string]interface{}) *medialive.M3u8Settings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.M3u8Settings{}
+
+	if v, ok := tfMap["audio_frames_per_pes"].(int); ok && v != 0 {
+		apiObject.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["audio_pids"].(string); ok && v != "" {
+		apiObject.AudioPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["ecm_pid"].(string); ok && v != "" {
+		apiObject.EcmPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["nielsen_id3_behavior"].(string); ok && v != "" {
+		apiObject.NielsenId3Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["pat_interval"].(int); ok && v != 0 {
+		apiObject.PatInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_control"].(string); ok && v != "" {
+		apiObject.PcrControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_period"].(int); ok && v != 0 {
+		apiObject.PcrPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_pid"].(string); ok && v != "" {
+		apiObject.PcrPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["pmt_interval"].(int); ok && v != 0 {
+		apiObject.PmtInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pmt_pid"].(string); ok && v != "" {
+		apiObject.PmtPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_num"].(int); ok && v != 0 {
+		apiObject.ProgramNum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scte35_behavior"].(string); ok && v != "" {
+		apiObject.Scte35Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_pid"].(string); ok && v != "" {
+		apiObject.Scte35Pid = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_behavior"].(string); ok && v != "" {
+		apiObject.TimedMetadataBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_pid"].(string); ok && v != "" {
+		apiObject.TimedMetadataPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["transport_stream_id"].(int); ok && v != 0 {
+		apiObject.TransportStreamId = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["video_pid"].(string); ok && v != "" {
+		apiObject.VideoPid = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiplexOutputSettings(tfMap map[string]interface{}) *medialive.MultiplexOutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.MultiplexOutputSettings{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandRtmpOutputSettings(tfMap map[string]interface{}) *medialive.RtmpOutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.RtmpOutputSettings{}
+
+	if v, ok := tfMap["certificate_mode"].(string); ok && v != "" {
+		apiObject.CertificateMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandUdpOutputSettings(tfMap map[string]interface{}) *medialive.UdpOutputSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.UdpOutputSettings{}
+
+	if v, ok := tfMap["buffer_msec"].(int); ok && v != 0 {
+		apiObject.BufferMsec = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["container_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerSettings = expandUdpContainerSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandOutputLocationRef(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandUdpContainerSettings(tfMap map[string]interface{}) *medialive.UdpContainerSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.UdpContainerSettings{}
+
+	if v, ok := tfMap["m2ts_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.M2tsSettings = expandM2tsSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTimecodeConfig(tfMap map[string]interface{}) *medialive.TimecodeConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.TimecodeConfig{}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["sync_threshold"].(int); ok && v != 0 {
+		apiObject.SyncThreshold = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandVideoDescription(tfMap map[string]interface{}) *medialive.VideoDescription {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.VideoDescription{}
+
+	if v, ok := tfMap["codec_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CodecSettings = expandVideoCodecSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["height"].(int); ok && v != 0 {
+		apiObject.Height = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["respond_to_afd"].(string); ok && v != "" {
+		apiObject.RespondToAfd = aws.String(v)
+	}
+
+	if v, ok := tfMap["scaling_behavior"].(string); ok && v != "" {
+		apiObject.ScalingBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["sharpness"].(int); ok && v != 0 {
+		apiObject.Sharpness = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["width"].(int); ok && v != 0 {
+		apiObject.Width = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandVideoDescriptions(tfList []interface{}) []*medialive.VideoDescription {
+	apiObjects := []*medialive.VideoDescription{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandVideoDescription(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVideoCodecSettings(tfMap map[string]interface{}) *medialive.VideoCodecSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.VideoCodecSettings{}
+
+	if v, ok := tfMap["frame_capture_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FrameCaptureSettings = expandFrameCaptureSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["h264_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.H264Settings = expandH264Settings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureSettings(tfMap map[string]interface{}) *medialive.FrameCaptureSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureSettings{}
+
+	if v, ok := tfMap["capture_interval"].(int); ok && v != 0 {
+		apiObject.CaptureInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["capture_interval_units"].(string); ok && v != "" {
+		apiObject.CaptureIntervalUnits = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandH264Settings(tfMap map[string]interface{}) *medialive.H264Settings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.H264Settings{}
+
+	if v, ok := tfMap["adaptive_quantization"].(string); ok && v != "" {
+		apiObject.AdaptiveQuantization = aws.String(v)
+	}
+
+	if v, ok := tfMap["afd_signaling"].(string); ok && v != "" {
+		apiObject.AfdSignaling = aws.String(v)
+	}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["buf_fill_pct"].(int); ok && v != 0 {
+		apiObject.BufFillPct = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["buf_size"].(int); ok && v != 0 {
+		apiObject.BufSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["color_metadata"].(string); ok && v != "" {
+		apiObject.ColorMetadata = aws.String(v)
+	}
+
+	if v, ok := tfMap["entropy_encoding"].(string); ok && v != "" {
+		apiObject.EntropyEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["fixed_afd"].(string); ok && v != "" {
+		apiObject.FixedAfd = aws.String(v)
+	}
+
+	if v, ok := tfMap["flicker_aq"].(string); ok && v != "" {
+		apiObject.FlickerAq = aws.String(v)
+	}
+
+	if v, ok := tfMap["force_field_pictures"].(string); ok && v != "" {
+		apiObject.ForceFieldPictures = aws.String(v)
+	}
+
+	if v, ok := tfMap["framerate_control"].(string); ok && v != "" {
+		apiObject.FramerateControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["framerate_denominator"].(int); ok && v != 0 {
+		apiObject.FramerateDenominator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["framerate_numerator"].(int); ok && v != 0 {
+		apiObject.FramerateNumerator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["gop_b_reference"].(string); ok && v != "" {
+		apiObject.GopBReference = aws.String(v)
+	}
+
+	if v, ok := tfMap["gop_closed_cadence"].(int); ok && v != 0 {
+		apiObject.GopClosedCadence = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["gop_num_b_frames"].(int); ok && v != 0 {
+		apiObject.GopNumBFrames = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["gop_size"].(float64); ok && v != 0 {
+		apiObject.GopSize = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["gop_size_units"].(string); ok && v != "" {
+		apiObject.GopSizeUnits = aws.String(v)
+	}
+
+	if v, ok := tfMap["level"].(string); ok && v != "" {
+		apiObject.Level = aws.String(v)
+	}
+
+	if v, ok := tfMap["look_ahead_rate_control"].(string); ok && v != "" {
+		apiObject.LookAheadRateControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_i_interval"].(int); ok && v != 0 {
+		apiObject.MinIInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["num_ref_frames"].(int); ok && v != 0 {
+		apiObject.NumRefFrames = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["par_control"].(string); ok && v != "" {
+		apiObject.ParControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["par_denominator"].(int); ok && v != 0 {
+		apiObject.ParDenominator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["par_numerator"].(int); ok && v != 0 {
+		apiObject.ParNumerator = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["profile"].(string); ok && v != "" {
+		apiObject.Profile = aws.String(v)
+	}
+
+	if v, ok := tfMap["quality_level"].(string); ok && v != "" {
+		apiObject.QualityLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["qvbr_quality_level"].(int); ok && v != 0 {
+		apiObject.QvbrQualityLevel = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+		apiObject.RateControlMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["scan_type"].(string); ok && v != "" {
+		apiObject.ScanType = aws.String(v)
+	}
+
+	if v, ok := tfMap["scene_change_detect"].(string); ok && v != "" {
+		apiObject.SceneChangeDetect = aws.String(v)
+	}
+
+	if v, ok := tfMap["slices"].(int); ok && v != 0 {
+		apiObject.Slices = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["softness"].(int); ok && v != 0 {
+		apiObject.Softness = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["spatial_aq"].(string); ok && v != "" {
+		apiObject.SpatialAq = aws.String(v)
+	}
+
+	if v, ok := tfMap["subgop_length"].(string); ok && v != "" {
+		apiObject.SubgopLength = aws.String(v)
+	}
+
+	if v, ok := tfMap["syntax"].(string); ok && v != "" {
+		apiObject.Syntax = aws.String(v)
+	}
+
+	if v, ok := tfMap["temporal_aq"].(string); ok && v != "" {
+		apiObject.TemporalAq = aws.String(v)
+	}
+
+	if v, ok := tfMap["timecode_insertion"].(string); ok && v != "" {
+		apiObject.TimecodeInsertion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncoderSettings(apiObject *medialive.EncoderSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioDescriptions; v != nil {
+		tfMap["audio_descriptions"] = flattenAudioDescriptions(v)
+	}
+
+	if v := apiObject.OutputGroups; v != nil {
+		tfMap["output_groups"] = flattenOutputGroups(v)
+	}
+
+	if v := apiObject.TimecodeConfig; v != nil {
+		tfMap["timecode_config"] = []interface{}{flattenTimecodeConfig(v)}
+	}
+
+	if v := apiObject.VideoDescriptions; v != nil {
+		tfMap["video_descriptions"] = flattenVideoDescriptions(v)
+	}
+
+	return tfMap
+}
+
+func flattenAudioDescription(apiObject *medialive.AudioDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioSelectorName; v != nil {
+		tfMap["audio_selector_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AudioType; v != nil {
+		tfMap["audio_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AudioTypeControl; v != nil {
+		tfMap["audio_type_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CodecSettings; v != nil {
+		tfMap["codec_settings"] = []interface{}{flattenAudioCodecSettings(v)}
+	}
+
+	if v := apiObject.LanguageCode; v != nil {
+		tfMap["language_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LanguageCodeControl; v != nil {
+		tfMap["language_code_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreamName; v != nil {
+		tfMap["stream_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAudioDescriptions(apiObjects []*medialive.AudioDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAudioDescription(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenAudioCodecSettings(apiObject *medialive.AudioCodecSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AacSettings; v != nil {
+		tfMap["aac_settings"] = []interface{}{flattenAacSettings(v)}
+	}
+
+	if v := apiObject.Ac3Settings; v != nil {
+		tfMap["ac3_settings"] = []interface{}{flattenAc3Settings(v)}
+	}
+
+	if v := apiObject.PassThroughSettings; v != nil {
+		tfMap["pass_through_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func flattenAacSettings(apiObject *medialive.AacSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bitrate; v != nil {
+		tfMap["bitrate"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.CodingMode; v != nil {
+		tfMap["coding_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InputType; v != nil {
+		tfMap["input_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Profile; v != nil {
+		tfMap["profile"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RateControlMode; v != nil {
+		tfMap["rate_control_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RawFormat; v != nil {
+		tfMap["raw_format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SampleRate; v != nil {
+		tfMap["sample_rate"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VbrQuality; v != nil {
+		tfMap["vbr_quality"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAc3Settings(apiObject *medialive.Ac3Settings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bitrate; v != nil {
+		tfMap["bitrate"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.BitstreamMode; v != nil {
+		tfMap["bitstream_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CodingMode; v != nil {
+		tfMap["coding_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Dialnorm; v != nil {
+		tfMap["dialnorm"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.DrcProfile; v != nil {
+		tfMap["drc_profile"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LfeFilter; v != nil {
+		tfMap["lfe_filter"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MetadataControl; v != nil {
+		tfMap["metadata_control"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputGroup(apiObject *medialive.OutputGroup) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutputGroupSettings; v != nil {
+		tfMap["output_group_settings"] = []interface{}{flattenOutputGroupSettings(v)}
+	}
+
+	if v := apiObject.Outputs; v != nil {
+		tfMap["outputs"] = flattenOutputs(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputGroups(apiObjects []*medialive.OutputGroup) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenOutputGroup(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenOutputGroupSettings(apiObject *medialive.OutputGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ArchiveGroupSettings; v != nil {
+		tfMap["archive_group_settings"] = []interface{}{flattenArchiveGroupSettings(v)}
+	}
+
+	if v := apiObject.FrameCaptureGroupSettings; v != nil {
+		tfMap["frame_capture_group_settings"] = []interface{}{flattenFrameCaptureGroupSettings(v)}
+	}
+
+	if v := apiObject.HlsGroupSettings; v != nil {
+		tfMap["hls_group_settings"] = []interface{}{flattenHlsGroupSettings(v)}
+	}
+
+	if v := apiObject.MediaPackageGroupSettings; v != nil {
+		tfMap["media_package_group_settings"] = []interface{}{flattenMediaPackageGroupSettings(v)}
+	}
+
+	if v := apiObject.MultiplexGroupSettings; v != nil {
+		tfMap["multiplex_group_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.RtmpGroupSettings; v != nil {
+		tfMap["rtmp_group_settings"] = []interface{}{flattenRtmpGroupSettings(v)}
+	}
+
+	if v := apiObject.UdpGroupSettings; v != nil {
+		tfMap["udp_group_settings"] = []interface{}{flattenUdpGroupSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenArchiveGroupSettings(apiObject *medialive.ArchiveGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	if v := apiObject.RolloverInterval; v != nil {
+		tfMap["rollover_interval"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputLocationRef(apiObject *medialive.OutputLocationRef) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationRefId; v != nil {
+		tfMap["destination_ref_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFrameCaptureGroupSettings(apiObject *medialive.FrameCaptureGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHlsGroupSettings(apiObject *medialive.HlsGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	if v := apiObject.DirectoryStructure; v != nil {
+		tfMap["directory_structure"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InputLossAction; v != nil {
+		tfMap["input_loss_action"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeepSegments; v != nil {
+		tfMap["keep_segments"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ManifestDurationFormat; v != nil {
+		tfMap["manifest_duration_format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MinSegmentLength; v != nil {
+		tfMap["min_segment_length"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Mode; v != nil {
+		tfMap["mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutputSelection; v != nil {
+		tfMap["output_selection"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ProgramDateTime; v != nil {
+		tfMap["program_date_time"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ProgramDateTimePeriod; v != nil {
+		tfMap["program_date_time_period"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SegmentLength; v != nil {
+		tfMap["segment_length"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SegmentationMode; v != nil {
+		tfMap["segmentation_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreamInfResolution; v != nil {
+		tfMap["stream_inf_resolution"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TsFileMode; v != nil {
+		tfMap["ts_file_mode"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenMediaPackageGroupSettings(apiObject *medialive.MediaPackageGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	return tfMap
+}
+
+func flattenRtmpGroupSettings(apiObject *medialive.RtmpGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuthenticationScheme; v != nil {
+		tfMap["authentication_scheme"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CacheFullBehavior; v != nil {
+		tfMap["cache_full_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CacheLength; v != nil {
+		tfMap["cache_length"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.CaptionData; v != nil {
+		tfMap["caption_data"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InputLossAction; v != nil {
+		tfMap["input_loss_action"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RestartDelay; v != nil {
+		tfMap["restart_delay"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenUdpGroupSettings(apiObject *medialive.UdpGroupSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InputLossAction; v != nil {
+		tfMap["input_loss_action"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimedMetadataId3Frame; v != nil {
+		tfMap["timed_metadata_id3_frame"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimedMetadataId3Period; v != nil {
+		tfMap["timed_metadata_id3_period"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutput(apiObject *medialive.Output) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioDescriptionNames; v != nil {
+		tfMap["audio_description_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.CaptionDescriptionNames; v != nil {
+		tfMap["caption_description_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.OutputName; v != nil {
+		tfMap["output_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutputSettings; v != nil {
+		tfMap["output_settings"] = []interface{}{flattenOutputSettings(v)}
+	}
+
+	if v := apiObject.VideoDescriptionName; v != nil {
+		tfMap["video_description_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOutputs(apiObjects []*medialive.Output) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenOutput(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenOutputSettings(apiObject *medialive.OutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ArchiveOutputSettings; v != nil {
+		tfMap["archive_output_settings"] = []interface{}{flattenArchiveOutputSettings(v)}
+	}
+
+	if v := apiObject.FrameCaptureOutputSettings; v != nil {
+		tfMap["frame_capture_output_settings"] = []interface{}{flattenFrameCaptureOutputSettings(v)}
+	}
+
+	if v := apiObject.HlsOutputSettings; v != nil {
+		tfMap["hls_output_settings"] = []interface{}{flattenHlsOutputSettings(v)}
+	}
+
+	if v := apiObject.MediaPackageOutputSettings; v != nil {
+		tfMap["media_package_output_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.MultiplexOutputSettings; v != nil {
+		tfMap["multiplex_output_settings"] = []interface{}{flattenMultiplexOutputSettings(v)}
+	}
+
+	if v := apiObject.RtmpOutputSettings; v != nil {
+		tfMap["rtmp_output_settings"] = []interface{}{flattenRtmpOutputSettings(v)}
+	}
+
+	if v := apiObject.UdpOutputSettings; v != nil {
+		tfMap["udp_output_settings"] = []interface{}{flattenUdpOutputSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenArchiveOutputSettings(apiObject *medialive.ArchiveOutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContainerSettings; v != nil {
+		tfMap["container_settings"] = []interface{}{flattenArchiveContainerSettings(v)}
+	}
+
+	if v := apiObject.Extension; v != nil {
+		tfMap["extension"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NameModifier; v != nil {
+		tfMap["name_modifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenArchiveContainerSettings(apiObject *medialive.ArchiveContainerSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.M2tsSettings; v != nil {
+		tfMap["m2ts_settings"] = []interface{}{flattenM2tsSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenM2tsSettings(apiObject *medialive.M2tsSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AbsentInputAudioBehavior; v != nil {
+		tfMap["absent_input_audio_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Arib; v != nil {
+		tfMap["arib"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AudioBufferModel; v != nil {
+		tfMap["audio_buffer_model"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AudioFramesPerPes; v != nil {
+		tfMap["audio_frames_per_pes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.AudioPids; v != nil {
+		tfMap["audio_pids"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AudioStreamType; v != nil {
+		tfMap["audio_stream_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Bitrate; v != nil {
+		tfMap["bitrate"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.BufferModel; v != nil {
+		tfMap["buffer_model"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CcDescriptor; v != nil {
+		tfMap["cc_descriptor"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Ebif; v != nil {
+		tfMap["ebif"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EsRateInPes; v != nil {
+		tfMap["es_rate_in_pes"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PcrControl; v != nil {
+		tfMap["pcr_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PcrPeriod; v != nil {
+		tfMap["pcr_period"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PcrPid; v != nil {
+		tfMap["pcr_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PmtInterval; v != nil {
+		tfMap["pmt_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PmtPid; v != nil {
+		tfMap["pmt_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ProgramNum; v != nil {
+		tfMap["program_num"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RateMode; v != nil {
+		tfMap["rate_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Scte35Control; v != nil {
+		tfMap["scte35_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Scte35Pid; v != nil {
+		tfMap["scte35_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SegmentationMarkers; v != nil {
+		tfMap["segmentation_markers"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SegmentationStyle; v != nil {
+		tfMap["segmentation_style"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SegmentationTime; v != nil {
+		tfMap["segmentation_time"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.TimedMetadataBehavior; v != nil {
+		tfMap["timed_metadata_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TransportStreamId; v != nil {
+		tfMap["transport_stream_id"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.VideoPid; v != nil {
+		tfMap["video_pid"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFrameCaptureOutputSettings(apiObject *medialive.FrameCaptureOutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NameModifier; v != nil {
+		tfMap["name_modifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenHlsOutputSettings(apiObject *medialive.HlsOutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.H265PackagingType; v != nil {
+		tfMap["h265_packaging_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HlsSettings; v != nil {
+		tfMap["hls_settings"] = []interface{}{flattenHlsSettings(v)}
+	}
+
+	if v := apiObject.NameModifier; v != nil {
+		tfMap["name_modifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SegmentModifier; v != nil {
+		tfMap["segment_modifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenHlsSettings(apiObject *medialive.HlsSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.StandardHlsSettings; v != nil {
+		tfMap["standard_hls_settings"] = []interface{}{flattenStandardHlsSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenStandardHlsSettings(apiObject *medialive.StandardHlsSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioRenditionSets; v != nil {
+		tfMap["audio_rendition_sets"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.M3u8Settings; v != nil {
+		tfMap["m3u8_settings"] = []interface{}{flattenM3u8Settings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenM3u8Settings(apiObject *medialive.M3u8Settings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioFramesPerPes; v != nil {
+		tfMap["audio_frames_per_pes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.AudioPids; v != nil {
+		tfMap["audio_pids"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EcmPid; v != nil {
+		tfMap["ecm_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NielsenId3Behavior; v != nil {
+		tfMap["nielsen_id3_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PatInterval; v != nil {
+		tfMap["pat_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PcrControl; v != nil {
+		tfMap["pcr_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PcrPeriod; v != nil {
+		tfMap["pcr_period"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PcrPid; v != nil {
+		tfMap["pcr_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PmtInterval; v != nil {
+		tfMap["pmt_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PmtPid; v != nil {
+		tfMap["pmt_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ProgramNum; v != nil {
+		tfMap["program_num"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Scte35Behavior; v != nil {
+		tfMap["scte35_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Scte35Pid; v != nil {
+		tfMap["scte35_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimedMetadataBehavior; v != nil {
+		tfMap["timed_metadata_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimedMetadataPid; v != nil {
+		tfMap["timed_metadata_pid"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TransportStreamId; v != nil {
+		tfMap["transport_stream_id"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.VideoPid; v != nil {
+		tfMap["video_pid"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenMultiplexOutputSettings(apiObject *medialive.MultiplexOutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	return tfMap
+}
+
+func flattenRtmpOutputSettings(apiObject *medialive.RtmpOutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CertificateMode; v != nil {
+		tfMap["certificate_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ConnectionRetryInterval; v != nil {
+		tfMap["connection_retry_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	if v := apiObject.NumRetries; v != nil {
+		tfMap["num_retries"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenUdpOutputSettings(apiObject *medialive.UdpOutputSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BufferMsec; v != nil {
+		tfMap["buffer_msec"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ContainerSettings; v != nil {
+		tfMap["container_settings"] = []interface{}{flattenUdpContainerSettings(v)}
+	}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenOutputLocationRef(v)}
+	}
+
+	return tfMap
+}
+
+func flattenUdpContainerSettings(apiObject *medialive.UdpContainerSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.M2tsSettings; v != nil {
+		tfMap["m2ts_settings"] = []interface{}{flattenM2tsSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTimecodeConfig(apiObject *medialive.TimecodeConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Source; v != nil {
+		tfMap["source"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SyncThreshold; v != nil {
+		tfMap["sync_threshold"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenVideoDescription(apiObject *medialive.VideoDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CodecSettings; v != nil {
+		tfMap["codec_settings"] = []interface{}{flattenVideoCodecSettings(v)}
+	}
+
+	if v := apiObject.Height; v != nil {
+		tfMap["height"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RespondToAfd; v != nil {
+		tfMap["respond_to_afd"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ScalingBehavior; v != nil {
+		tfMap["scaling_behavior"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Sharpness; v != nil {
+		tfMap["sharpness"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Width; v != nil {
+		tfMap["width"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenVideoDescriptions(apiObjects []*medialive.VideoDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenVideoDescription(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenVideoCodecSettings(apiObject *medialive.VideoCodecSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FrameCaptureSettings; v != nil {
+		tfMap["frame_capture_settings"] = []interface{}{flattenFrameCaptureSettings(v)}
+	}
+
+	if v := apiObject.H264Settings; v != nil {
+		tfMap["h264_settings"] = []interface{}{flattenH264Settings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenFrameCaptureSettings(apiObject *medialive.FrameCaptureSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CaptureInterval; v != nil {
+		tfMap["capture_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.CaptureIntervalUnits; v != nil {
+		tfMap["capture_interval_units"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenH264Settings(apiObject *medialive.H264Settings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AdaptiveQuantization; v != nil {
+		tfMap["adaptive_quantization"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AfdSignaling; v != nil {
+		tfMap["afd_signaling"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Bitrate; v != nil {
+		tfMap["bitrate"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.BufFillPct; v != nil {
+		tfMap["buf_fill_pct"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.BufSize; v != nil {
+		tfMap["buf_size"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ColorMetadata; v != nil {
+		tfMap["color_metadata"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EntropyEncoding; v != nil {
+		tfMap["entropy_encoding"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FixedAfd; v != nil {
+		tfMap["fixed_afd"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FlickerAq; v != nil {
+		tfMap["flicker_aq"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ForceFieldPictures; v != nil {
+		tfMap["force_field_pictures"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FramerateControl; v != nil {
+		tfMap["framerate_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FramerateDenominator; v != nil {
+		tfMap["framerate_denominator"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.FramerateNumerator; v != nil {
+		tfMap["framerate_numerator"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.GopBReference; v != nil {
+		tfMap["gop_b_reference"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.GopClosedCadence; v != nil {
+		tfMap["gop_closed_cadence"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.GopNumBFrames; v != nil {
+		tfMap["gop_num_b_frames"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.GopSize; v != nil {
+		tfMap["gop_size"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.GopSizeUnits; v != nil {
+		tfMap["gop_size_units"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Level; v != nil {
+		tfMap["level"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LookAheadRateControl; v != nil {
+		tfMap["look_ahead_rate_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MaxBitrate; v != nil {
+		tfMap["max_bitrate"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinIInterval; v != nil {
+		tfMap["min_i_interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.NumRefFrames; v != nil {
+		tfMap["num_ref_frames"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ParControl; v != nil {
+		tfMap["par_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ParDenominator; v != nil {
+		tfMap["par_denominator"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ParNumerator; v != nil {
+		tfMap["par_numerator"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Profile; v != nil {
+		tfMap["profile"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.QualityLevel; v != nil {
+		tfMap["quality_level"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.QvbrQualityLevel; v != nil {
+		tfMap["qvbr_quality_level"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RateControlMode; v != nil {
+		tfMap["rate_control_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ScanType; v != nil {
+		tfMap["scan_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SceneChangeDetect; v != nil {
+		tfMap["scene_change_detect"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Slices; v != nil {
+		tfMap["slices"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Softness; v != nil {
+		tfMap["softness"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SpatialAq; v != nil {
+		tfMap["spatial_aq"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SubgopLength; v != nil {
+		tfMap["subgop_length"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Syntax; v != nil {
+		tfMap["syntax"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TemporalAq; v != nil {
+		tfMap["temporal_aq"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimecodeInsertion; v != nil {
+		tfMap["timecode_insertion"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,394 @@
+package medialive_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaLiveChannel_basic(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig(rName, rName, 640, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", medialive.ChannelClassSinglePipeline),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.0.id", "destination"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.0.media_package_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.audio_descriptions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.output_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.timecode_config.0.source", medialive.TimecodeConfigSourceEmbedded),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.video_descriptions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.video_descriptions.0.width", "640"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_attachments.0.input_id", "aws_medialive_input.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", medialive.InputCodecAvc),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_disappears(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig(rName, rName, 640, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_tags(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccChannelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_startChannel(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig(rName, rName, 640, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					testAccCheckChannelState(&v, medialive.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "true"),
+				),
+			},
+			{
+				// Updating a running channel stops it, applies the update and starts it again.
+				Config: testAccChannelConfig(rName, rName+"-updated", 1280, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					testAccCheckChannelState(&v, medialive.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.video_descriptions.0.width", "1280"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "true"),
+				),
+			},
+			{
+				Config: testAccChannelConfig(rName, rName+"-updated", 1280, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					testAccCheckChannelState(&v, medialive.ChannelStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelExists(n string, v *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		output, err := tfmedialive.FindChannelByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckChannelState(v *medialive.DescribeChannelOutput, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := *v.State; got != state {
+			return fmt.Errorf("MediaLive Channel state is %s, expected %s", got, state)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		_, err := tfmedialive.FindChannelByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccChannelBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "medialive.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:DescribeLogStreams",
+        "logs:PutLogEvents",
+        "mediapackage:DescribeChannel",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rules {
+    cidr = "10.0.0.8/32"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+  type                  = "UDP_PUSH"
+}
+
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelResourceConfig(name string, width int, startChannel bool, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = "SINGLE_PIPELINE"
+  role_arn      = aws_iam_role.test.arn
+  start_channel = %[3]t
+
+  depends_on = [aws_iam_role_policy.test]
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "input"
+    input_id              = aws_medialive_input.test.id
+  }
+
+  destinations {
+    id = "destination"
+
+    media_package_settings {
+      channel_id = aws_media_package_channel.test.channel_id
+    }
+  }
+
+  encoder_settings {
+    timecode_config {
+      source = "EMBEDDED"
+    }
+
+    audio_descriptions {
+      audio_selector_name = "default"
+      name                = "audio"
+    }
+
+    video_descriptions {
+      name   = "video"
+      width  = %[2]d
+      height = %[2]d * 9 / 16
+
+      codec_settings {
+        h264_settings {
+          framerate_control     = "SPECIFIED"
+          framerate_denominator = 1
+          framerate_numerator   = 30
+          rate_control_mode     = "CBR"
+          bitrate               = 1000000
+        }
+      }
+    }
+
+    output_groups {
+      output_group_settings {
+        media_package_group_settings {
+          destination {
+            destination_ref_id = "destination"
+          }
+        }
+      }
+
+      outputs {
+        output_name             = "output"
+        audio_description_names = ["audio"]
+        video_description_name  = "video"
+
+        output_settings {
+          media_package_output_settings {}
+        }
+      }
+    }
+  }
+
+%[4]s
+}
+`, name, width, startChannel, tags)
+}
+
+func testAccChannelConfig(rName, name string, width int, startChannel bool) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(name, width, startChannel, ""))
+}
+
+func testAccChannelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, 640, false, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1)))
+}
+
+func testAccChannelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, 640, false, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2)))
+}
@@ -0,0 +1,491 @@
+package medialive
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInputCreate,
+		Read:   resourceInputRead,
+		Update: resourceInputUpdate,
+		Delete: resourceInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_channels": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_connect_flows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(medialive.InputType_Values(), false),
+			},
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateInputInput{
+		Name:      aws.String(name),
+		RequestId: aws.String(resource.UniqueId()),
+		Type:      aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("destinations"); ok && len(v.([]interface{})) > 0 {
+		input.Destinations = expandInputDestinationRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_security_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.InputSecurityGroups = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("media_connect_flows"); ok && len(v.([]interface{})) > 0 {
+		input.MediaConnectFlows = expandMediaConnectFlowRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sources"); ok && len(v.([]interface{})) > 0 {
+		input.Sources = expandInputSourceRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Vpc = expandInputVpcRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input: %s", input)
+	output, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Input.Id))
+
+	if _, err := WaitInputCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceInputRead(d, meta)
+}
+
+func resourceInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindInputByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("attached_channels", aws.StringValueSlice(output.AttachedChannels))
+	if err := d.Set("destinations", flattenInputDestinations(output.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %w", err)
+	}
+	d.Set("input_class", output.InputClass)
+	d.Set("input_security_groups", aws.StringValueSlice(output.SecurityGroups))
+	d.Set("input_source_type", output.InputSourceType)
+	if err := d.Set("media_connect_flows", flattenMediaConnectFlows(output.MediaConnectFlows)); err != nil {
+		return fmt.Errorf("error setting media_connect_flows: %w", err)
+	}
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("sources", flattenInputSources(output.Sources)); err != nil {
+		return fmt.Errorf("error setting sources: %w", err)
+	}
+	d.Set("type", output.Type)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &medialive.UpdateInputInput{
+			InputId: aws.String(d.Id()),
+			Name:    aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("destinations"); ok && len(v.([]interface{})) > 0 {
+			input.Destinations = expandInputDestinationRequests(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("input_security_groups"); ok && v.(*schema.Set).Len() > 0 {
+			input.InputSecurityGroups = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("media_connect_flows"); ok && len(v.([]interface{})) > 0 {
+			input.MediaConnectFlows = expandMediaConnectFlowRequests(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("sources"); ok && len(v.([]interface{})) > 0 {
+			input.Sources = expandInputSourceRequests(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input: %s", input)
+		_, err := conn.UpdateInput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceInputRead(d, meta)
+}
+
+func resourceInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input: %s", d.Id())
+	_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+		InputId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitInputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputDestinationRequests(tfList []interface{}) []*medialive.InputDestinationRequest {
+	var apiObjects []*medialive.InputDestinationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputDestinationRequest{}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInputSourceRequests(tfList []interface{}) []*medialive.InputSourceRequest {
+	var apiObjects []*medialive.InputSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputSourceRequest{}
+
+		if v, ok := tfMap["password_param"].(string); ok && v != "" {
+			apiObject.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := tfMap["url"].(string); ok && v != "" {
+			apiObject.Url = aws.String(v)
+		}
+
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			apiObject.Username = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaConnectFlowRequests(tfList []interface{}) []*medialive.MediaConnectFlowRequest {
+	var apiObjects []*medialive.MediaConnectFlowRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.MediaConnectFlowRequest{}
+
+		if v, ok := tfMap["flow_arn"].(string); ok && v != "" {
+			apiObject.FlowArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInputVpcRequest(tfMap map[string]interface{}) *medialive.InputVpcRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.InputVpcRequest{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenInputDestinations(apiObjects []*medialive.InputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"ip":   aws.StringValue(apiObject.Ip),
+			"port": aws.StringValue(apiObject.Port),
+			"url":  aws.StringValue(apiObject.Url),
+		}
+
+		// The stream name is not returned by the API, it is the path component of the push URL.
+		if u, err := url.Parse(aws.StringValue(apiObject.Url)); err == nil {
+			tfMap["stream_name"] = strings.TrimPrefix(u.Path, "/")
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenInputSources(apiObjects []*medialive.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"password_param": aws.StringValue(apiObject.PasswordParam),
+			"url":            aws.StringValue(apiObject.Url),
+			"username":       aws.StringValue(apiObject.Username),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaConnectFlows(apiObjects []*medialive.MediaConnectFlow) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"flow_arn": aws.StringValue(apiObject.FlowArn),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,208 @@
+package medialive
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInputSecurityGroupCreate,
+		Read:   resourceInputSecurityGroupRead,
+		Update: resourceInputSecurityGroupUpdate,
+		Delete: resourceInputSecurityGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"whitelist_rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandInputWhitelistRuleCidrs(d.Get("whitelist_rules").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	output, err := conn.CreateInputSecurityGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input Security Group: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroup.Id))
+
+	return resourceInputSecurityGroupRead(d, meta)
+}
+
+func resourceInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindInputSecurityGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input Security Group %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input Security Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("inputs", aws.StringValueSlice(output.Inputs))
+	if err := d.Set("whitelist_rules", flattenInputWhitelistRules(output.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rules: %w", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChange("whitelist_rules") {
+		input := &medialive.UpdateInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(d.Id()),
+			WhitelistRules:       expandInputWhitelistRuleCidrs(d.Get("whitelist_rules").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+		_, err := conn.UpdateInputSecurityGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitInputSecurityGroupUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Input Security Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceInputSecurityGroupRead(d, meta)
+}
+
+func resourceInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input Security Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputWhitelistRuleCidrs(tfList []interface{}) []*medialive.InputWhitelistRuleCidr {
+	var apiObjects []*medialive.InputWhitelistRuleCidr
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputWhitelistRuleCidr{}
+
+		if v, ok := tfMap["cidr"].(string); ok && v != "" {
+			apiObject.Cidr = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenInputWhitelistRules(apiObjects []*medialive.InputWhitelistRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cidr": aws.StringValue(apiObject.Cidr),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,157 @@
+package medialive
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	channelCreatedTimeout = 15 * time.Minute
+	channelUpdatedTimeout = 15 * time.Minute
+	channelDeletedTimeout = 15 * time.Minute
+	channelStartedTimeout = 15 * time.Minute
+	channelStoppedTimeout = 15 * time.Minute
+
+	inputCreatedTimeout = 5 * time.Minute
+	inputDeletedTimeout = 5 * time.Minute
+
+	inputSecurityGroupUpdatedTimeout = 5 * time.Minute
+)
+
+func WaitChannelCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateCreating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: StatusChannelState(conn, id),
+		Timeout: channelCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitChannelUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateUpdating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: StatusChannelState(conn, id),
+		Timeout: channelUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitChannelDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateDeleting},
+		Target:  []string{},
+		Refresh: StatusChannelState(conn, id),
+		Timeout: channelDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitChannelStarted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStarting},
+		Target:  []string{medialive.ChannelStateRunning},
+		Refresh: StatusChannelState(conn, id),
+		Timeout: channelStartedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitChannelStopped(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStopping},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: StatusChannelState(conn, id),
+		Timeout: channelStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInputCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateCreating},
+		Target:  []string{medialive.InputStateDetached, medialive.InputStateAttached},
+		Refresh: StatusInputState(conn, id),
+		Timeout: inputCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInputDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateDeleting},
+		Target:  []string{},
+		Refresh: StatusInputState(conn, id),
+		Timeout: inputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInputSecurityGroupUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target:  []string{medialive.InputSecurityGroupStateIdle, medialive.InputSecurityGroupStateInUse},
+		Refresh: StatusInputSecurityGroupState(conn, id),
+		Timeout: inputSecurityGroupUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
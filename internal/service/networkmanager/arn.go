@@ -0,0 +1,42 @@
+package networkmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceImportByARN imports a resource that belongs to a global network using its ARN,
+// e.g. arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223.
+func resourceImportByARN(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	globalNetworkID, resourceID, err := parseResourceARN(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(resourceID)
+	d.Set("global_network_id", globalNetworkID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseResourceARN returns the global network ID and resource ID from the ARN of a resource that belongs to a global network.
+func parseResourceARN(v string) (string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	// See https://docs.aws.amazon.com/service-authorization/latest/reference/list_networkmanager.html#networkmanager-resources-for-iam-policies.
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("unexpected format for ARN (%s), expected arn:PARTITION:networkmanager::ACCOUNT:TYPE/GLOBAL-NETWORK-ID/RESOURCE-ID", v)
+	}
+
+	return parts[1], parts[2], nil
+}
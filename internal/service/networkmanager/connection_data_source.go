@@ -0,0 +1,80 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connected_device_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connected_link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	connectionID := d.Get("connection_id").(string)
+	connection, err := FindConnectionByTwoPartKey(conn, globalNetworkID, connectionID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Connection (%s): %w", connectionID, err)
+	}
+
+	d.SetId(connectionID)
+	d.Set("arn", connection.ConnectionArn)
+	d.Set("connected_device_id", connection.ConnectedDeviceId)
+	d.Set("connected_link_id", connection.ConnectedLinkId)
+	d.Set("connection_id", connection.ConnectionId)
+	d.Set("description", connection.Description)
+	d.Set("device_id", connection.DeviceId)
+	d.Set("global_network_id", connection.GlobalNetworkId)
+	d.Set("link_id", connection.LinkId)
+
+	if err := d.Set("tags", KeyValueTags(connection.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
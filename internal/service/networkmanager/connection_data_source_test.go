@@ -0,0 +1,60 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerConnectionDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_connection.test"
+	resourceName := "aws_networkmanager_connection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connected_device_id", resourceName, "connected_device_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connected_link_id", resourceName, "connected_link_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connection_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "device_id", resourceName, "device_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "link_id", resourceName, "link_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectionDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccConnectionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_networkmanager_connection" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  device_id           = aws_networkmanager_device.test1.id
+  connected_device_id = aws_networkmanager_device.test2.id
+  description         = "test"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_networkmanager_connection" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  connection_id     = aws_networkmanager_connection.test.id
+}
+`, rName))
+}
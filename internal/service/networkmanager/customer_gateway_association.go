@@ -0,0 +1,173 @@
+package networkmanager
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomerGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomerGatewayAssociationCreate,
+		Read:   resourceCustomerGatewayAssociationRead,
+		Delete: resourceCustomerGatewayAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"customer_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCustomerGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	customerGatewayARN := d.Get("customer_gateway_arn").(string)
+	id := CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN)
+	input := &networkmanager.AssociateCustomerGatewayInput{
+		CustomerGatewayArn: aws.String(customerGatewayARN),
+		DeviceId:           aws.String(d.Get("device_id").(string)),
+		GlobalNetworkId:    aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("link_id"); ok {
+		input.LinkId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Customer Gateway Association: %s", input)
+	_, err := tfresource.RetryWhen(customerGatewayAssociationCreatedTimeout,
+		func() (interface{}, error) {
+			return conn.AssociateCustomerGateway(input)
+		},
+		func(err error) (bool, error) {
+			// The customer gateway's VPN connection must be attached to a registered transit gateway.
+			if tfawserr.ErrMessageContains(err, networkmanager.ErrCodeValidationException, "is not attached to a transit gateway that is registered in your global network") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Customer Gateway Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitCustomerGatewayAssociationCreated(conn, globalNetworkID, customerGatewayARN); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Customer Gateway Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceCustomerGatewayAssociationRead(d, meta)
+}
+
+func resourceCustomerGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID, customerGatewayARN, err := CustomerGatewayAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindCustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Customer Gateway Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Customer Gateway Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("customer_gateway_arn", output.CustomerGatewayArn)
+	d.Set("device_id", output.DeviceId)
+	d.Set("global_network_id", output.GlobalNetworkId)
+	d.Set("link_id", output.LinkId)
+
+	return nil
+}
+
+func resourceCustomerGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID, customerGatewayARN, err := CustomerGatewayAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Customer Gateway Association: %s", d.Id())
+	_, err = conn.DisassociateCustomerGateway(&networkmanager.DisassociateCustomerGatewayInput{
+		CustomerGatewayArn: aws.String(customerGatewayARN),
+		GlobalNetworkId:    aws.String(globalNetworkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Customer Gateway Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitCustomerGatewayAssociationDeleted(conn, globalNetworkID, customerGatewayARN); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Customer Gateway Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const customerGatewayAssociationIDSeparator = ","
+
+func CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN string) string {
+	parts := []string{globalNetworkID, customerGatewayARN}
+	id := strings.Join(parts, customerGatewayAssociationIDSeparator)
+
+	return id
+}
+
+func CustomerGatewayAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customerGatewayAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sCUSTOMER-GATEWAY-ARN", id, customerGatewayAssociationIDSeparator)
+}
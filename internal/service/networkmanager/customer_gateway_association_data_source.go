@@ -0,0 +1,61 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceCustomerGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCustomerGatewayAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"customer_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomerGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	customerGatewayARN := d.Get("customer_gateway_arn").(string)
+	id := CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN)
+	output, err := FindCustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Customer Gateway Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("customer_gateway_arn", output.CustomerGatewayArn)
+	d.Set("device_id", output.DeviceId)
+	d.Set("global_network_id", output.GlobalNetworkId)
+	d.Set("link_id", output.LinkId)
+	d.Set("state", output.State)
+
+	return nil
+}
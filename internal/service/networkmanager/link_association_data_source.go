@@ -0,0 +1,72 @@
+package networkmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceLinkAssociation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinkAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"device_id", "link_id"},
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"device_id", "link_id"},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLinkAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.GetLinkAssociationsInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("device_id"); ok {
+		input.DeviceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("link_id"); ok {
+		input.LinkId = aws.String(v.(string))
+	}
+
+	output, err := FindLinkAssociation(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("Network Manager Link Association", err)
+	}
+
+	linkID := aws.StringValue(output.LinkId)
+	deviceID := aws.StringValue(output.DeviceId)
+
+	d.SetId(LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID))
+	d.Set("device_id", deviceID)
+	d.Set("global_network_id", output.GlobalNetworkId)
+	d.Set("link_id", linkID)
+	d.Set("state", output.LinkAssociationState)
+
+	return nil
+}
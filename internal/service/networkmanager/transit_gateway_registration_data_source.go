@@ -0,0 +1,61 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTransitGatewayRegistration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayRegistrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	transitGatewayARN := d.Get("transit_gateway_arn").(string)
+	id := TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN)
+	output, err := FindTransitGatewayRegistrationByTwoPartKey(conn, globalNetworkID, transitGatewayARN)
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Transit Gateway Registration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("global_network_id", output.GlobalNetworkId)
+	if output.State != nil {
+		d.Set("state", output.State.Code)
+		d.Set("state_message", output.State.Message)
+	} else {
+		d.Set("state", nil)
+		d.Set("state_message", nil)
+	}
+	d.Set("transit_gateway_arn", output.TransitGatewayArn)
+
+	return nil
+}
@@ -0,0 +1,407 @@
+package networkmanager
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	globalNetworkCreatedTimeout = 10 * time.Minute
+	globalNetworkDeletedTimeout = 10 * time.Minute
+	globalNetworkUpdatedTimeout = 10 * time.Minute
+
+	siteCreatedTimeout = 10 * time.Minute
+	siteDeletedTimeout = 10 * time.Minute
+	siteUpdatedTimeout = 10 * time.Minute
+
+	deviceCreatedTimeout = 10 * time.Minute
+	deviceDeletedTimeout = 10 * time.Minute
+	deviceUpdatedTimeout = 10 * time.Minute
+
+	linkCreatedTimeout = 10 * time.Minute
+	linkDeletedTimeout = 10 * time.Minute
+	linkUpdatedTimeout = 10 * time.Minute
+
+	connectionCreatedTimeout = 10 * time.Minute
+	connectionDeletedTimeout = 10 * time.Minute
+	connectionUpdatedTimeout = 10 * time.Minute
+
+	linkAssociationCreatedTimeout = 10 * time.Minute
+	linkAssociationDeletedTimeout = 10 * time.Minute
+
+	transitGatewayRegistrationCreatedTimeout = 10 * time.Minute
+	transitGatewayRegistrationDeletedTimeout = 10 * time.Minute
+
+	customerGatewayAssociationCreatedTimeout = 10 * time.Minute
+	customerGatewayAssociationDeletedTimeout = 10 * time.Minute
+)
+
+func WaitGlobalNetworkCreated(conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Timeout: globalNetworkCreatedTimeout,
+		Refresh: StatusGlobalNetworkState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitGlobalNetworkDeleted(conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:  []string{},
+		Timeout: globalNetworkDeletedTimeout,
+		Refresh: StatusGlobalNetworkState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitGlobalNetworkUpdated(conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Timeout: globalNetworkUpdatedTimeout,
+		Refresh: StatusGlobalNetworkState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteCreated(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStatePending},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Timeout: siteCreatedTimeout,
+		Refresh: StatusSiteState(conn, globalNetworkID, siteID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteDeleted(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateDeleting},
+		Target:  []string{},
+		Timeout: siteDeletedTimeout,
+		Refresh: StatusSiteState(conn, globalNetworkID, siteID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteUpdated(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateUpdating},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Timeout: siteUpdatedTimeout,
+		Refresh: StatusSiteState(conn, globalNetworkID, siteID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitDeviceCreated(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStatePending},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Timeout: deviceCreatedTimeout,
+		Refresh: StatusDeviceState(conn, globalNetworkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitDeviceDeleted(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateDeleting},
+		Target:  []string{},
+		Timeout: deviceDeletedTimeout,
+		Refresh: StatusDeviceState(conn, globalNetworkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitDeviceUpdated(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateUpdating},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Timeout: deviceUpdatedTimeout,
+		Refresh: StatusDeviceState(conn, globalNetworkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLinkCreated(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStatePending},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Timeout: linkCreatedTimeout,
+		Refresh: StatusLinkState(conn, globalNetworkID, linkID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLinkDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateDeleting},
+		Target:  []string{},
+		Timeout: linkDeletedTimeout,
+		Refresh: StatusLinkState(conn, globalNetworkID, linkID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLinkUpdated(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateUpdating},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Timeout: linkUpdatedTimeout,
+		Refresh: StatusLinkState(conn, globalNetworkID, linkID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitConnectionCreated(conn *networkmanager.NetworkManager, globalNetworkID, connectionID string) (*networkmanager.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ConnectionStatePending},
+		Target:  []string{networkmanager.ConnectionStateAvailable},
+		Timeout: connectionCreatedTimeout,
+		Refresh: StatusConnectionState(conn, globalNetworkID, connectionID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitConnectionDeleted(conn *networkmanager.NetworkManager, globalNetworkID, connectionID string) (*networkmanager.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ConnectionStateDeleting},
+		Target:  []string{},
+		Timeout: connectionDeletedTimeout,
+		Refresh: StatusConnectionState(conn, globalNetworkID, connectionID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitConnectionUpdated(conn *networkmanager.NetworkManager, globalNetworkID, connectionID string) (*networkmanager.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ConnectionStateUpdating},
+		Target:  []string{networkmanager.ConnectionStateAvailable},
+		Timeout: connectionUpdatedTimeout,
+		Refresh: StatusConnectionState(conn, globalNetworkID, connectionID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLinkAssociationCreated(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStatePending},
+		Target:  []string{networkmanager.LinkAssociationStateAvailable},
+		Timeout: linkAssociationCreatedTimeout,
+		Refresh: StatusLinkAssociationState(conn, globalNetworkID, linkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLinkAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStateDeleting},
+		Target:  []string{},
+		Timeout: linkAssociationDeletedTimeout,
+		Refresh: StatusLinkAssociationState(conn, globalNetworkID, linkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayRegistrationCreated(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Timeout: transitGatewayRegistrationCreatedTimeout,
+		Refresh: StatusTransitGatewayRegistrationState(conn, globalNetworkID, transitGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if state := output.State; state != nil && aws.StringValue(state.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(state.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayRegistrationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateDeleting},
+		Target:  []string{},
+		Timeout: transitGatewayRegistrationDeletedTimeout,
+		Refresh: StatusTransitGatewayRegistrationState(conn, globalNetworkID, transitGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if state := output.State; state != nil && aws.StringValue(state.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(state.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCustomerGatewayAssociationCreated(conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string) (*networkmanager.CustomerGatewayAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CustomerGatewayAssociationStatePending},
+		Target:  []string{networkmanager.CustomerGatewayAssociationStateAvailable},
+		Timeout: customerGatewayAssociationCreatedTimeout,
+		Refresh: StatusCustomerGatewayAssociationState(conn, globalNetworkID, customerGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.CustomerGatewayAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCustomerGatewayAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string) (*networkmanager.CustomerGatewayAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CustomerGatewayAssociationStateDeleting},
+		Target:  []string{},
+		Timeout: customerGatewayAssociationDeletedTimeout,
+		Refresh: StatusCustomerGatewayAssociationState(conn, globalNetworkID, customerGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.CustomerGatewayAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,137 @@
+package opensearch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	domainUpgradeSuccessMinTimeout = 10 * time.Second
+	domainUpgradeSuccessDelay      = 30 * time.Second
+	domainRetryTimeout             = 60 * time.Minute
+	domainDeleteRetryTimeout       = 90 * time.Minute
+)
+
+// UpgradeSucceeded waits for an Upgrade to return Success
+func waitUpgradeSucceeded(conn *opensearchservice.OpenSearchService, name string, timeout time.Duration) (*opensearchservice.GetUpgradeStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{opensearchservice.UpgradeStatusInProgress},
+		Target:     []string{opensearchservice.UpgradeStatusSucceeded},
+		Refresh:    statusUpgradeStatus(conn, name),
+		Timeout:    timeout,
+		MinTimeout: domainUpgradeSuccessMinTimeout,
+		Delay:      domainUpgradeSuccessDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.GetUpgradeStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitForDomainCreation(conn *opensearchservice.OpenSearchService, domainName string) error {
+	var out *opensearchservice.DomainStatus
+	err := resource.Retry(domainRetryTimeout, func() *resource.RetryError {
+		var err error
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !aws.BoolValue(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
+			return nil
+		}
+
+		return resource.RetryableError(
+			fmt.Errorf("%q: Timeout while waiting for the domain to be created", domainName))
+	})
+	if tfresource.TimedOut(err) {
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return fmt.Errorf("Error describing OpenSearch domain: %w", err)
+		}
+		if !aws.BoolValue(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenSearch domain to be created: %w", err)
+	}
+	return nil
+}
+
+func waitForDomainUpdate(conn *opensearchservice.OpenSearchService, domainName string) error {
+	var out *opensearchservice.DomainStatus
+	err := resource.Retry(domainRetryTimeout, func() *resource.RetryError {
+		var err error
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !aws.BoolValue(out.Processing) {
+			return nil
+		}
+
+		return resource.RetryableError(
+			fmt.Errorf("%q: Timeout while waiting for changes to be processed", domainName))
+	})
+	if tfresource.TimedOut(err) {
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return fmt.Errorf("Error describing OpenSearch domain: %w", err)
+		}
+		if !aws.BoolValue(out.Processing) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenSearch domain changes to be processed: %w", err)
+	}
+	return nil
+}
+
+func waitForDomainDelete(conn *opensearchservice.OpenSearchService, domainName string) error {
+	var out *opensearchservice.DomainStatus
+	err := resource.Retry(domainDeleteRetryTimeout, func() *resource.RetryError {
+		var err error
+		out, err = FindDomainByName(conn, domainName)
+
+		if err != nil {
+			if tfresource.NotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if out != nil && !aws.BoolValue(out.Processing) {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("timeout while waiting for the domain %q to be deleted", domainName))
+	})
+	if tfresource.TimedOut(err) {
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			if tfresource.NotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("Error describing OpenSearch domain: %s", err)
+		}
+		if out != nil && !aws.BoolValue(out.Processing) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenSearch domain to be deleted: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package rbin
+
+import (
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+)
+
+// These constants are missing from the AWS SDK
+const (
+	resourceTypeEC2Image = "EC2_IMAGE"
+)
+
+func resourceType_Values() []string {
+	return append(recyclebin.ResourceType_Values(), resourceTypeEC2Image)
+}
@@ -0,0 +1,58 @@
+package rbin
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindRuleByID(conn *recyclebin.RecycleBin, id string) (*recyclebin.GetRuleOutput, error) {
+	input := &recyclebin.GetRuleInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetRule(input)
+
+	if tfawserr.ErrCodeEquals(err, recyclebin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRules(conn *recyclebin.RecycleBin, input *recyclebin.ListRulesInput) ([]*recyclebin.RuleSummary, error) {
+	var output []*recyclebin.RuleSummary
+
+	err := conn.ListRulesPages(input, func(page *recyclebin.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Rules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
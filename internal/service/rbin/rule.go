@@ -0,0 +1,322 @@
+package rbin
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRuleCreate,
+		Read:   resourceRuleRead,
+		Update: resourceRuleUpdate,
+		Delete: resourceRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"resource_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem:     resourceTagsElem(),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(resourceType_Values(), false),
+			},
+			"retention_period": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_period_unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(recyclebin.RetentionPeriodUnit_Values(), false),
+						},
+						"retention_period_value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 3650),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RBinConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &recyclebin.CreateRuleInput{
+		ResourceType:    aws.String(d.Get("resource_type").(string)),
+		RetentionPeriod: expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTags = expandResourceTags(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating RBin Rule: %s", input)
+	output, err := conn.CreateRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RBin Rule: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Identifier))
+
+	if _, err := waitRuleAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RBin Rule (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceRuleRead(d, meta)
+}
+
+func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RBinConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindRuleByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RBin Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RBin Rule (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   recyclebin.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	if err := d.Set("resource_tags", flattenResourceTags(output.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %w", err)
+	}
+	d.Set("resource_type", output.ResourceType)
+	if err := d.Set("retention_period", flattenRetentionPeriod(output.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %w", err)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for RBin Rule (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RBinConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &recyclebin.UpdateRuleInput{
+			Description:     aws.String(d.Get("description").(string)),
+			Identifier:      aws.String(d.Id()),
+			ResourceTags:    expandResourceTags(d.Get("resource_tags").(*schema.Set).List()),
+			ResourceType:    aws.String(d.Get("resource_type").(string)),
+			RetentionPeriod: expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating RBin Rule: %s", input)
+		_, err := conn.UpdateRule(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating RBin Rule (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitRuleAvailable(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for RBin Rule (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating RBin Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceRuleRead(d, meta)
+}
+
+func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RBinConn
+
+	log.Printf("[DEBUG] Deleting RBin Rule: %s", d.Id())
+	_, err := conn.DeleteRule(&recyclebin.DeleteRuleInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, recyclebin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RBin Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTagsElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"resource_tag_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"resource_tag_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+		},
+	}
+}
+
+func expandResourceTags(tfList []interface{}) []*recyclebin.ResourceTag {
+	// An empty list clears the resource tags on update.
+	apiObjects := []*recyclebin.ResourceTag{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &recyclebin.ResourceTag{}
+
+		if v, ok := tfMap["resource_tag_key"].(string); ok && v != "" {
+			apiObject.ResourceTagKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["resource_tag_value"].(string); ok && v != "" {
+			apiObject.ResourceTagValue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenResourceTags(apiObjects []*recyclebin.ResourceTag) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"resource_tag_key":   aws.StringValue(apiObject.ResourceTagKey),
+			"resource_tag_value": aws.StringValue(apiObject.ResourceTagValue),
+		})
+	}
+
+	return tfList
+}
+
+func expandRetentionPeriod(tfList []interface{}) *recyclebin.RetentionPeriod {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &recyclebin.RetentionPeriod{}
+
+	if v, ok := tfMap["retention_period_unit"].(string); ok && v != "" {
+		apiObject.RetentionPeriodUnit = aws.String(v)
+	}
+
+	if v, ok := tfMap["retention_period_value"].(int); ok && v != 0 {
+		apiObject.RetentionPeriodValue = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRetentionPeriod(apiObject *recyclebin.RetentionPeriod) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"retention_period_unit":  aws.StringValue(apiObject.RetentionPeriodUnit),
+		"retention_period_value": aws.Int64Value(apiObject.RetentionPeriodValue),
+	}
+
+	return []interface{}{tfMap}
+}
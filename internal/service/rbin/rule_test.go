@@ -0,0 +1,280 @@
+package rbin_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrbin "github.com/hashicorp/terraform-provider-aws/internal/service/rbin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRBinRule_basic(t *testing.T) {
+	resourceName := "aws_rbin_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(recyclebin.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, recyclebin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rbin", regexp.MustCompile(`rule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_tags.*", map[string]string{
+						"resource_tag_key":   rName,
+						"resource_tag_value": "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", recyclebin.ResourceTypeEbsSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.retention_period_unit", recyclebin.RetentionPeriodUnitDays),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.retention_period_value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "status", recyclebin.RuleStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRBinRule_disappears(t *testing.T) {
+	resourceName := "aws_rbin_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(recyclebin.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, recyclebin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrbin.ResourceRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRBinRule_update(t *testing.T) {
+	resourceName := "aws_rbin_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(recyclebin.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, recyclebin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfigUpdate(rName, "description 1", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.retention_period_value", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfigUpdate(rName, "description 2", 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.retention_period_value", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRBinRule_tags(t *testing.T) {
+	resourceName := "aws_rbin_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(recyclebin.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, recyclebin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRuleConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RBin Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RBinConn
+
+		_, err := tfrbin.FindRuleByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RBinConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rbin_rule" {
+			continue
+		}
+
+		_, err := tfrbin.FindRuleByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RBin Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRuleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  resource_type = "EBS_SNAPSHOT"
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = "true"
+  }
+
+  retention_period {
+    retention_period_value = 7
+    retention_period_unit  = "DAYS"
+  }
+}
+`, rName)
+}
+
+func testAccRuleConfigUpdate(rName, description string, retentionPeriodValue int) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  description   = %[2]q
+  resource_type = "EBS_SNAPSHOT"
+
+  retention_period {
+    retention_period_value = %[3]d
+    retention_period_unit  = "DAYS"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, description, retentionPeriodValue)
+}
+
+func testAccRuleConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  resource_type = "EBS_SNAPSHOT"
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = "true"
+  }
+
+  retention_period {
+    retention_period_value = 7
+    retention_period_unit  = "DAYS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccRuleConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  resource_type = "EBS_SNAPSHOT"
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = "true"
+  }
+
+  retention_period {
+    retention_period_value = 7
+    retention_period_unit  = "DAYS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,106 @@
+package rbin
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem:     resourceTagsElem(),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceType_Values(), false),
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"retention_period_unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"retention_period_value": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RBinConn
+
+	input := &recyclebin.ListRulesInput{
+		ResourceType: aws.String(d.Get("resource_type").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTags = expandResourceTags(v.(*schema.Set).List())
+	}
+
+	output, err := FindRules(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading RBin Rules: %w", err)
+	}
+
+	var ruleIDs []string
+	var rules []interface{}
+
+	for _, v := range output {
+		ruleIDs = append(ruleIDs, aws.StringValue(v.Identifier))
+		rules = append(rules, map[string]interface{}{
+			"description":      aws.StringValue(v.Description),
+			"id":               aws.StringValue(v.Identifier),
+			"retention_period": flattenRetentionPeriod(v.RetentionPeriod),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", ruleIDs)
+	if err := d.Set("rules", rules); err != nil {
+		return fmt.Errorf("error setting rules: %w", err)
+	}
+
+	return nil
+}
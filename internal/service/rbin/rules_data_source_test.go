@@ -0,0 +1,66 @@
+package rbin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRBinRulesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_rbin_rules.test"
+	resourceName := "aws_rbin_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(recyclebin.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, recyclebin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.retention_period.0.retention_period_unit", resourceName, "retention_period.0.retention_period_unit"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.retention_period.0.retention_period_value", resourceName, "retention_period.0.retention_period_value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRulesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  description   = %[1]q
+  resource_type = "EBS_SNAPSHOT"
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = "true"
+  }
+
+  retention_period {
+    retention_period_value = 10
+    retention_period_unit  = "DAYS"
+  }
+}
+
+data "aws_rbin_rules" "test" {
+  resource_type = aws_rbin_rule.test.resource_type
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = "true"
+  }
+}
+`, rName)
+}
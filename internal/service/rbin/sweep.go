@@ -0,0 +1,70 @@
+//go:build sweep
+// +build sweep
+
+package rbin
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_rbin_rule", &resource.Sweeper{
+		Name: "aws_rbin_rule",
+		F:    sweepRules,
+	})
+}
+
+func sweepRules(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RBinConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	for _, resourceType := range resourceType_Values() {
+		input := &recyclebin.ListRulesInput{
+			ResourceType: aws.String(resourceType),
+		}
+
+		err := conn.ListRulesPages(input, func(page *recyclebin.ListRulesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, rule := range page.Rules {
+				r := ResourceRule()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(rule.Identifier))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping RBin Rule sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing RBin Rules (%s) for %s: %w", resourceType, region, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RBin Rules (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,29 @@
+package rbin
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/recyclebin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ruleAvailableTimeout = 5 * time.Minute
+)
+
+func waitRuleAvailable(conn *recyclebin.RecycleBin, id string) (*recyclebin.GetRuleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{recyclebin.RuleStatusPending},
+		Target:  []string{recyclebin.RuleStatusAvailable},
+		Refresh: statusRule(conn, id),
+		Timeout: ruleAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*recyclebin.GetRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,4 @@
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTagsForDomain -ListTagsInIDElem=DomainName -ListTagsOutTagsElem=TagList -ServiceTagsSlice -TagOp=UpdateTagsForDomain -TagInIDElem=DomainName -TagInTagsElem=TagsToUpdate -UntagOp=DeleteTagsForDomain -UntagInTagsElem=TagsToDelete -UpdateTags
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package route53domains
@@ -0,0 +1,876 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncDefaultContentType = "application/octet-stream"
+
+	// DeleteObjects accepts at most 1000 keys per request.
+	directorySyncDeleteBatchSize = 1000
+)
+
+// directorySyncContentTypes maps lower-case file extensions to MIME types.
+// The system MIME database is deliberately not consulted so that plans are identical on every machine.
+var directorySyncContentTypes = map[string]string{
+	".avif":        "image/avif",
+	".bmp":         "image/bmp",
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".eot":         "application/vnd.ms-fontobject",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/vnd.microsoft.icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".webm":        "video/webm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "application/xml",
+	".yaml":        "application/yaml",
+	".yml":         "application/yaml",
+	".zip":         "application/zip",
+}
+
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDirectorySyncCreate,
+		Read:   resourceDirectorySyncRead,
+		Update: resourceDirectorySyncUpdate,
+		Delete: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_extra_objects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validDirectorySyncPattern,
+				},
+			},
+			"files": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				// Objects are listed and deleted by prefix, so "assets" would also match "assets2/".
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`/$`), "must end with a /"),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(s3manager.DefaultUploadPartSize),
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDirectorySyncPattern,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("key_prefix").(string)
+
+	files, err := buildDirectorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := directorySyncUpload(d, meta, files); err != nil {
+		return err
+	}
+
+	d.SetId(DirectorySyncCreateResourceID(bucket, prefix))
+
+	if d.Get("delete_extra_objects").(bool) {
+		if err := directorySyncDeleteExtraObjects(meta.(*conns.AWSClient).S3Conn, bucket, prefix, files); err != nil {
+			return err
+		}
+	}
+
+	return resourceDirectorySyncRead(d, meta)
+}
+
+func resourceDirectorySyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("key_prefix").(string)
+
+	remoteKeys, err := directorySyncListKeys(conn, bucket, prefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	var files []*directorySyncFile
+
+	// Objects that have been deleted outside of Terraform are dropped from the manifest so that they are uploaded again.
+	for _, file := range expandDirectorySyncFiles(d.Get("files").(*schema.Set).List()) {
+		if _, ok := remoteKeys[file.key]; !ok {
+			continue
+		}
+
+		files = append(files, file)
+		delete(remoteKeys, file.key)
+	}
+
+	// Objects not present locally are recorded without a source so that their deletion shows in the plan.
+	if d.Get("delete_extra_objects").(bool) {
+		for key := range remoteKeys {
+			files = append(files, &directorySyncFile{key: key})
+		}
+	}
+
+	if err := d.Set("files", flattenDirectorySyncFiles(files)); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("key_prefix").(string)
+
+	files, err := buildDirectorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	oldFiles := make(map[string]*directorySyncFile)
+
+	for _, file := range expandDirectorySyncFiles(o.(*schema.Set).List()) {
+		oldFiles[file.key] = file
+	}
+
+	// A change to any object-wide setting requires every object to be uploaded again.
+	uploadAll := d.HasChanges("acl", "kms_key_id", "server_side_encryption", "storage_class")
+
+	var uploads []*directorySyncFile
+
+	for _, file := range files {
+		if old, ok := oldFiles[file.key]; uploadAll || !ok || !old.equal(file) {
+			uploads = append(uploads, file)
+		}
+
+		delete(oldFiles, file.key)
+	}
+
+	if err := directorySyncUpload(d, meta, uploads); err != nil {
+		return err
+	}
+
+	var deletes []string
+
+	for key := range oldFiles {
+		deletes = append(deletes, key)
+	}
+
+	if err := directorySyncDeleteObjects(conn, bucket, deletes); err != nil {
+		return err
+	}
+
+	if d.Get("delete_extra_objects").(bool) {
+		if err := directorySyncDeleteExtraObjects(conn, bucket, prefix, files); err != nil {
+			return err
+		}
+	}
+
+	return resourceDirectorySyncRead(d, meta)
+}
+
+func resourceDirectorySyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+
+	for _, file := range expandDirectorySyncFiles(d.Get("files").(*schema.Set).List()) {
+		// Only delete objects uploaded by this resource.
+		if file.source == "" {
+			continue
+		}
+
+		keys = append(keys, file.key)
+	}
+
+	err := directorySyncDeleteObjects(conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	return err
+}
+
+// resourceDirectorySyncCustomizeDiff computes the manifest from the local directory at plan time
+// so that the plan shows exactly which objects will be uploaded or deleted.
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"content_types", "excludes", "key_prefix", "rule", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	files, err := buildDirectorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("files", flattenDirectorySyncFiles(files))
+}
+
+type directorySyncFile struct {
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentType        string
+	key                string
+	metadata           map[string]string
+	path               string
+	source             string
+	sourceHash         string
+}
+
+func (f *directorySyncFile) equal(other *directorySyncFile) bool {
+	if f.cacheControl != other.cacheControl ||
+		f.contentDisposition != other.contentDisposition ||
+		f.contentEncoding != other.contentEncoding ||
+		f.contentType != other.contentType ||
+		f.key != other.key ||
+		f.source != other.source ||
+		f.sourceHash != other.sourceHash ||
+		len(f.metadata) != len(other.metadata) {
+		return false
+	}
+
+	for k, v := range f.metadata {
+		if other.metadata[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+type directorySyncRule struct {
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentType        string
+	metadata           map[string]string
+	pattern            string
+}
+
+// directorySyncConfig is implemented by both schema.ResourceData and schema.ResourceDiff.
+type directorySyncConfig interface {
+	Get(string) interface{}
+}
+
+// buildDirectorySyncManifest walks the source directory and returns the objects that should exist in the bucket.
+func buildDirectorySyncManifest(d directorySyncConfig) ([]*directorySyncFile, error) {
+	sourceDir := d.Get("source_dir").(string)
+	prefix := d.Get("key_prefix").(string)
+
+	dir, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	var excludes []string
+
+	for _, v := range d.Get("excludes").(*schema.Set).List() {
+		excludes = append(excludes, v.(string))
+	}
+
+	contentTypes := make(map[string]string, len(directorySyncContentTypes))
+
+	for k, v := range directorySyncContentTypes {
+		contentTypes[k] = v
+	}
+
+	for k, v := range d.Get("content_types").(map[string]interface{}) {
+		k = strings.ToLower(k)
+
+		if !strings.HasPrefix(k, ".") {
+			k = "." + k
+		}
+
+		contentTypes[k] = v.(string)
+	}
+
+	rules := expandDirectorySyncRules(d.Get("rule").([]interface{}))
+
+	var files []*directorySyncFile
+
+	err = filepath.WalkDir(dir, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if filename == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filename)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if directorySyncMatchAny(excludes, rel) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		info, err := os.Stat(filename)
+
+		if err != nil {
+			return err
+		}
+
+		// Symbolic links to directories are not followed.
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		hash, err := directorySyncFileHash(filename)
+
+		if err != nil {
+			return err
+		}
+
+		file := &directorySyncFile{
+			contentType: directorySyncDefaultContentType,
+			key:         prefix + rel,
+			metadata:    make(map[string]string),
+			path:        filename,
+			source:      rel,
+			sourceHash:  hash,
+		}
+
+		if v, ok := contentTypes[strings.ToLower(path.Ext(rel))]; ok {
+			file.contentType = v
+		}
+
+		// Later rules take precedence over earlier ones.
+		for _, rule := range rules {
+			if !directorySyncMatch(rule.pattern, rel) {
+				continue
+			}
+
+			if rule.cacheControl != "" {
+				file.cacheControl = rule.cacheControl
+			}
+
+			if rule.contentDisposition != "" {
+				file.contentDisposition = rule.contentDisposition
+			}
+
+			if rule.contentEncoding != "" {
+				file.contentEncoding = rule.contentEncoding
+			}
+
+			if rule.contentType != "" {
+				file.contentType = rule.contentType
+			}
+
+			for k, v := range rule.metadata {
+				file.metadata[k] = v
+			}
+		}
+
+		files = append(files, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func directorySyncUpload(d *schema.ResourceData, meta interface{}, files []*directorySyncFile) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("multipart_part_size").(int))
+	})
+
+	bucket := d.Get("bucket").(string)
+
+	for _, file := range files {
+		input := &s3manager.UploadInput{
+			ACL:         aws.String(d.Get("acl").(string)),
+			Bucket:      aws.String(bucket),
+			ContentType: aws.String(file.contentType),
+			Key:         aws.String(file.key),
+		}
+
+		if file.cacheControl != "" {
+			input.CacheControl = aws.String(file.cacheControl)
+		}
+
+		if file.contentDisposition != "" {
+			input.ContentDisposition = aws.String(file.contentDisposition)
+		}
+
+		if file.contentEncoding != "" {
+			input.ContentEncoding = aws.String(file.contentEncoding)
+		}
+
+		if len(file.metadata) > 0 {
+			input.Metadata = aws.StringMap(file.metadata)
+		}
+
+		if v, ok := d.GetOk("storage_class"); ok {
+			input.StorageClass = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.ServerSideEncryption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.SSEKMSKeyId = aws.String(v.(string))
+			input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		}
+
+		if err := directorySyncUploadFile(uploader, input, file.path); err != nil {
+			return fmt.Errorf("error uploading %s to S3 Bucket (%s) Object (%s): %w", file.source, bucket, file.key, err)
+		}
+	}
+
+	return nil
+}
+
+func directorySyncUploadFile(uploader *s3manager.Uploader, input *s3manager.UploadInput, filename string) error {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	input.Body = f
+
+	log.Printf("[DEBUG] Uploading S3 Object: %s", aws.StringValue(input.Key))
+	_, err = uploader.Upload(input)
+
+	return err
+}
+
+// directorySyncListKeys returns the keys of all objects under the specified prefix.
+// Zero-byte "folder" placeholder objects are ignored.
+func directorySyncListKeys(conn *s3.S3, bucket, prefix string) (map[string]struct{}, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]struct{})
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+
+			keys[key] = struct{}{}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func directorySyncDeleteExtraObjects(conn *s3.S3, bucket, prefix string, files []*directorySyncFile) error {
+	remoteKeys, err := directorySyncListKeys(conn, bucket, prefix)
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+	}
+
+	for _, file := range files {
+		delete(remoteKeys, file.key)
+	}
+
+	var keys []string
+
+	for key := range remoteKeys {
+		keys = append(keys, key)
+	}
+
+	return directorySyncDeleteObjects(conn, bucket, keys)
+}
+
+func directorySyncDeleteObjects(conn *s3.S3, bucket string, keys []string) error {
+	sort.Strings(keys)
+
+	for i := 0; i < len(keys); i += directorySyncDeleteBatchSize {
+		j := i + directorySyncDeleteBatchSize
+		if j > len(keys) {
+			j = len(keys)
+		}
+
+		var objects []*s3.ObjectIdentifier
+
+		for _, key := range keys[i:j] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		}
+
+		log.Printf("[DEBUG] Deleting %d S3 Objects from S3 Bucket (%s)", len(objects), bucket)
+		output, err := conn.DeleteObjects(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting S3 Bucket (%s) Objects: %w", bucket, err)
+		}
+
+		if output != nil && len(output.Errors) > 0 {
+			v := output.Errors[0]
+
+			return fmt.Errorf("error deleting S3 Bucket (%s) Object (%s): %s: %s", bucket, aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message))
+		}
+	}
+
+	return nil
+}
+
+// directorySyncMatch returns whether the slash-separated relative path matches the glob pattern.
+// Patterns without a slash are also matched against the base name, so "*.html" matches at any depth.
+func directorySyncMatch(pattern, name string) bool {
+	if ok, _ := path.Match(pattern, name); ok {
+		return true
+	}
+
+	if !strings.Contains(pattern, "/") {
+		if ok, _ := path.Match(pattern, path.Base(name)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func directorySyncMatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if directorySyncMatch(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func directorySyncFileHash(filename string) (string, error) {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func validDirectorySyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid glob pattern: %q", k, value))
+	}
+
+	return
+}
+
+func expandDirectorySyncRules(tfList []interface{}) []*directorySyncRule {
+	var rules []*directorySyncRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &directorySyncRule{
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentType:        tfMap["content_type"].(string),
+			pattern:            tfMap["pattern"].(string),
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok {
+			rule.metadata = aws.StringValueMap(flex.ExpandStringMap(v))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandDirectorySyncFiles(tfList []interface{}) []*directorySyncFile {
+	var files []*directorySyncFile
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		file := &directorySyncFile{
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentType:        tfMap["content_type"].(string),
+			key:                tfMap["key"].(string),
+			metadata:           make(map[string]string),
+			source:             tfMap["source"].(string),
+			sourceHash:         tfMap["source_hash"].(string),
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok {
+			file.metadata = aws.StringValueMap(flex.ExpandStringMap(v))
+		}
+
+		files = append(files, file)
+	}
+
+	return files
+}
+
+func flattenDirectorySyncFiles(files []*directorySyncFile) []interface{} {
+	var tfList []interface{}
+
+	for _, file := range files {
+		tfList = append(tfList, map[string]interface{}{
+			"cache_control":       file.cacheControl,
+			"content_disposition": file.contentDisposition,
+			"content_encoding":    file.contentEncoding,
+			"content_type":        file.contentType,
+			"key":                 file.key,
+			"metadata":            flex.PointersMapToStringList(aws.StringMap(file.metadata)),
+			"source":              file.source,
+			"source_hash":         file.sourceHash,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,204 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDirectorySyncMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "*.html", name: "index.html", want: true},
+		{pattern: "*.html", name: "docs/guide/index.html", want: true},
+		{pattern: "*.html", name: "index.htm", want: false},
+		{pattern: "docs/*.html", name: "docs/index.html", want: true},
+		{pattern: "docs/*.html", name: "docs/guide/index.html", want: false},
+		{pattern: "docs/*.html", name: "index.html", want: false},
+		{pattern: ".git", name: ".git", want: true},
+		{pattern: ".git", name: "vendor/.git", want: true},
+		{pattern: "img/*", name: "img/logo.png", want: true},
+		{pattern: "[", name: "[", want: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+" "+testCase.name, func(t *testing.T) {
+			if got := directorySyncMatch(testCase.pattern, testCase.name); got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncContentTypes(t *testing.T) {
+	for k, v := range directorySyncContentTypes {
+		if !strings.HasPrefix(k, ".") || k != strings.ToLower(k) {
+			t.Errorf("extension %q must be lower case and start with a .", k)
+		}
+
+		if !strings.Contains(v, "/") {
+			t.Errorf("extension %q: %q is not a MIME type", k, v)
+		}
+
+		if strings.HasPrefix(v, "text/") && !strings.HasSuffix(v, "; charset=utf-8") {
+			t.Errorf("extension %q: %q does not specify a charset", k, v)
+		}
+	}
+}
+
+func TestBuildDirectorySyncManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"img/logo.PNG":      "png",
+		"data.custom":       "custom",
+		"LICENSE":           "license",
+		"drafts/post.md":    "draft",
+		"docs/guide/a.html": "<html></html>",
+	}
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		raw              map[string]interface{}
+		wantKeys         []string
+		wantContentTypes map[string]string
+		wantCacheControl map[string]string
+	}{
+		{
+			name: "defaults",
+			raw:  map[string]interface{}{},
+			wantKeys: []string{
+				"LICENSE",
+				"css/site.css",
+				"data.custom",
+				"docs/guide/a.html",
+				"drafts/post.md",
+				"img/logo.PNG",
+				"index.html",
+			},
+			wantContentTypes: map[string]string{
+				"LICENSE":      directorySyncDefaultContentType,
+				"css/site.css": "text/css; charset=utf-8",
+				"data.custom":  directorySyncDefaultContentType,
+				"img/logo.PNG": "image/png",
+				"index.html":   "text/html; charset=utf-8",
+			},
+		},
+		{
+			name: "key prefix and excludes",
+			raw: map[string]interface{}{
+				"key_prefix": "site/",
+				"excludes":   []interface{}{"drafts", "*.md", "LICENSE"},
+			},
+			wantKeys: []string{
+				"site/css/site.css",
+				"site/data.custom",
+				"site/docs/guide/a.html",
+				"site/img/logo.PNG",
+				"site/index.html",
+			},
+		},
+		{
+			name: "content types",
+			raw: map[string]interface{}{
+				"excludes":      []interface{}{"drafts", "docs", "img", "LICENSE"},
+				"content_types": map[string]interface{}{"CUSTOM": "application/x-custom", ".css": "text/plain"},
+			},
+			wantKeys: []string{"css/site.css", "data.custom", "index.html"},
+			wantContentTypes: map[string]string{
+				"css/site.css": "text/plain",
+				"data.custom":  "application/x-custom",
+				"index.html":   "text/html; charset=utf-8",
+			},
+		},
+		{
+			name: "rules",
+			raw: map[string]interface{}{
+				"excludes": []interface{}{"drafts", "img", "LICENSE", "data.custom"},
+				"rule": []interface{}{
+					map[string]interface{}{"pattern": "*", "cache_control": "max-age=3600"},
+					map[string]interface{}{"pattern": "*.html", "cache_control": "no-cache", "content_type": "text/html"},
+				},
+			},
+			wantKeys: []string{"css/site.css", "docs/guide/a.html", "index.html"},
+			wantContentTypes: map[string]string{
+				"css/site.css":      "text/css; charset=utf-8",
+				"docs/guide/a.html": "text/html",
+				"index.html":        "text/html",
+			},
+			wantCacheControl: map[string]string{
+				"css/site.css":      "max-age=3600",
+				"docs/guide/a.html": "no-cache",
+				"index.html":        "no-cache",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"bucket":     "test",
+				"source_dir": dir,
+			}
+
+			for k, v := range testCase.raw {
+				raw[k] = v
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceDirectorySync().Schema, raw)
+
+			got, err := buildDirectorySyncManifest(d)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotKeys []string
+			bySource := make(map[string]*directorySyncFile)
+			for _, file := range got {
+				gotKeys = append(gotKeys, file.key)
+				bySource[file.source] = file
+
+				if file.sourceHash == "" {
+					t.Errorf("%s: missing source hash", file.source)
+				}
+			}
+
+			if strings.Join(gotKeys, ",") != strings.Join(testCase.wantKeys, ",") {
+				t.Fatalf("got keys %v, expected %v", gotKeys, testCase.wantKeys)
+			}
+
+			for source, want := range testCase.wantContentTypes {
+				if got := bySource[source].contentType; got != want {
+					t.Errorf("%s: got content type %q, expected %q", source, got, want)
+				}
+			}
+
+			for source, want := range testCase.wantCacheControl {
+				if got := bySource[source].cacheControl; got != want {
+					t.Errorf("%s: got cache control %q, expected %q", source, got, want)
+				}
+			}
+		})
+	}
+}
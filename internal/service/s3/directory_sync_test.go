@@ -0,0 +1,244 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+		"notes.md":     "excluded",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "files.*", map[string]string{
+						"key":           "site/index.html",
+						"source":        "index.html",
+						"content_type":  "text/html; charset=utf-8",
+						"cache_control": "no-cache",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "files.*", map[string]string{
+						"key":           "site/css/site.css",
+						"source":        "css/site.css",
+						"content_type":  "text/css; charset=utf-8",
+						"cache_control": "max-age=31536000",
+						"metadata.%":    "1",
+						"metadata.team": "web",
+					}),
+					testAccCheckDirectorySyncObject(rName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckDirectorySyncObject(rName, "site/css/site.css", "text/css; charset=utf-8", "max-age=31536000"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+						"index.html": "<html><body></body></html>",
+					})
+					testAccDirectorySyncPutObject(t, rName, "site/extra.txt")
+				},
+				Config: testAccDirectorySyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.#", "2"),
+					testAccCheckDirectorySyncObjectNotExists(rName, "site/extra.txt"),
+				),
+			},
+			{
+				Config:   testAccDirectorySyncConfig(rName, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_removeFile(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<html></html>",
+		"error.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "error.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.#", "1"),
+					testAccCheckDirectorySyncObjectNotExists(rName, "site/error.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_sync" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "files.") || !strings.HasSuffix(k, ".key") {
+				continue
+			}
+
+			_, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(v),
+			})
+
+			if err == nil {
+				return fmt.Errorf("S3 Object %s still exists", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectorySyncObject(bucket, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Object (%s): %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type: got %q, expected %q", key, got, contentType)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control: got %q, expected %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 Object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncPutObject(t *testing.T, bucket, key string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	_, err := conn.PutObject(&s3.PutObjectInput{
+		Body:   strings.NewReader("extra"),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		t.Fatalf("error putting S3 Object (%s): %s", key, err)
+	}
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncConfig(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.id
+  key_prefix = "site/"
+  source_dir = %[2]q
+  excludes   = ["*.md"]
+
+  delete_extra_objects = true
+
+  rule {
+    pattern       = "*"
+    cache_control = "no-cache"
+  }
+
+  rule {
+    pattern       = "css/*"
+    cache_control = "max-age=31536000"
+
+    metadata = {
+      team = "web"
+    }
+  }
+}
+`, rName, dir)
+}
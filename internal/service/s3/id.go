@@ -39,3 +39,12 @@ func ParseResourceID(id string) (bucket, expectedBucketOwner string, err error)
 	err = fmt.Errorf("unexpected format for ID (%s), expected BUCKET or BUCKET%sEXPECTED_BUCKET_OWNER", id, resourceIDSeparator)
 	return
 }
+
+// DirectorySyncCreateResourceID creates an ID string for an aws_s3_directory_sync resource from a bucket name and key prefix.
+func DirectorySyncCreateResourceID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return bucket + "/" + keyPrefix
+}
@@ -0,0 +1,73 @@
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3ControlStorageLensConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	dataSourceName := "data.aws_s3control_storage_lens_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "config_id", resourceName, "config_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.#", resourceName, "storage_lens_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.0.enabled", resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.0.enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.enabled", resourceName, "storage_lens_configuration.0.enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.include.0.regions.#", resourceName, "storage_lens_configuration.0.include.0.regions.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageLensConfigurationDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      activity_metrics {
+        enabled = true
+      }
+
+      bucket_level {}
+    }
+
+    include {
+      regions = [data.aws_region.current.name]
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_s3control_storage_lens_configuration" "test" {
+  config_id = aws_s3control_storage_lens_configuration.test.config_id
+}
+`, rName)
+}
@@ -92,3 +92,88 @@ func bucketUpdateTags(conn *s3control.S3Control, identifier string, oldTagsMap i
 
 	return nil
 }
+
+// storageLensConfigurationListTags lists S3control Storage Lens configuration tags.
+func storageLensConfigurationListTags(conn *s3control.S3Control, accountID, configID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetStorageLensConfigurationTaggingInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	}
+
+	output, err := conn.GetStorageLensConfigurationTagging(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTagsFromStorageLensTags(output.Tags), nil
+}
+
+// storageLensConfigurationUpdateTags updates S3control Storage Lens configuration tags.
+func storageLensConfigurationUpdateTags(conn *s3control.S3Control, accountID, configID string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := storageLensConfigurationListTags(conn, accountID, configID)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s): %w", configID, err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutStorageLensConfigurationTaggingInput{
+			AccountId: aws.String(accountID),
+			ConfigId:  aws.String(configID),
+			Tags:      StorageLensTags(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutStorageLensConfigurationTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s): %w", configID, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteStorageLensConfigurationTaggingInput{
+			AccountId: aws.String(accountID),
+			ConfigId:  aws.String(configID),
+		}
+
+		_, err := conn.DeleteStorageLensConfigurationTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s): %w", configID, err)
+		}
+	}
+
+	return nil
+}
+
+// StorageLensTags returns s3control service tags for Storage Lens configurations.
+func StorageLensTags(tags tftags.KeyValueTags) []*s3control.StorageLensTag {
+	result := make([]*s3control.StorageLensTag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3control.StorageLensTag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// KeyValueTagsFromStorageLensTags creates tftags.KeyValueTags from s3control service Storage Lens tags.
+func KeyValueTagsFromStorageLensTags(tags []*s3control.StorageLensTag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
@@ -0,0 +1,522 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSetEventDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigurationSetEventDestinationCreate,
+		Read:   resourceConfigurationSetEventDestinationRead,
+		Update: resourceConfigurationSetEventDestinationUpdate,
+		Delete: resourceConfigurationSetEventDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_dimension_value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"dimension_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"dimension_value_source": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(sesv2.DimensionValueSource_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"kinesis_firehose_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"iam_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"matching_event_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.EventType_Values(), false),
+							},
+						},
+						"pinpoint_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"sns_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+					},
+				},
+			},
+			"event_destination_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceConfigurationSetEventDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	eventDestinationName := d.Get("event_destination_name").(string)
+	id := ConfigurationSetEventDestinationCreateResourceID(configurationSetName, eventDestinationName)
+	input := &sesv2.CreateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandEventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set Event Destination: %s", input)
+	_, err := conn.CreateConfigurationSetEventDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set Event Destination (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceConfigurationSetEventDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	eventDestination, err := FindConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Configuration Set Event Destination %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	d.Set("configuration_set_name", configurationSetName)
+	if err := d.Set("event_destination", []interface{}{flattenEventDestination(eventDestination)}); err != nil {
+		return fmt.Errorf("error setting event_destination: %w", err)
+	}
+	d.Set("event_destination_name", eventDestination.Name)
+
+	return nil
+}
+
+func resourceConfigurationSetEventDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &sesv2.UpdateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandEventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Updating SESv2 Configuration Set Event Destination: %s", input)
+	_, err = conn.UpdateConfigurationSetEventDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	return resourceConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceConfigurationSetEventDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set Event Destination: %s", d.Id())
+	_, err = conn.DeleteConfigurationSetEventDestination(&sesv2.DeleteConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestinationName: aws.String(eventDestinationName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const configurationSetEventDestinationResourceIDSeparator = "|"
+
+func ConfigurationSetEventDestinationCreateResourceID(configurationSetName, eventDestinationName string) string {
+	parts := []string{configurationSetName, eventDestinationName}
+	id := strings.Join(parts, configurationSetEventDestinationResourceIDSeparator)
+
+	return id
+}
+
+func ConfigurationSetEventDestinationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configurationSetEventDestinationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGURATION-SET-NAME%[2]sEVENT-DESTINATION-NAME", id, configurationSetEventDestinationResourceIDSeparator)
+}
+
+func expandEventDestinationDefinition(tfMap map[string]interface{}) *sesv2.EventDestinationDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.EventDestinationDefinition{}
+
+	if v, ok := tfMap["cloud_watch_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchDestination = expandCloudWatchDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["kinesis_firehose_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisFirehoseDestination = expandKinesisFirehoseDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["matching_event_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchingEventTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["pinpoint_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PinpointDestination = expandPinpointDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sns_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnsDestination = expandSnsDestination(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchDestination(tfMap map[string]interface{}) *sesv2.CloudWatchDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.CloudWatchDestination{}
+
+	if v, ok := tfMap["dimension_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DimensionConfigurations = expandCloudWatchDimensionConfigurations(v)
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchDimensionConfigurations(tfList []interface{}) []*sesv2.CloudWatchDimensionConfiguration {
+	var apiObjects []*sesv2.CloudWatchDimensionConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sesv2.CloudWatchDimensionConfiguration{}
+
+		if v, ok := tfMap["default_dimension_value"].(string); ok && v != "" {
+			apiObject.DefaultDimensionValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["dimension_name"].(string); ok && v != "" {
+			apiObject.DimensionName = aws.String(v)
+		}
+
+		if v, ok := tfMap["dimension_value_source"].(string); ok && v != "" {
+			apiObject.DimensionValueSource = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandKinesisFirehoseDestination(tfMap map[string]interface{}) *sesv2.KinesisFirehoseDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.KinesisFirehoseDestination{}
+
+	if v, ok := tfMap["delivery_stream_arn"].(string); ok && v != "" {
+		apiObject.DeliveryStreamArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["iam_role_arn"].(string); ok && v != "" {
+		apiObject.IamRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPinpointDestination(tfMap map[string]interface{}) *sesv2.PinpointDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.PinpointDestination{}
+
+	if v, ok := tfMap["application_arn"].(string); ok && v != "" {
+		apiObject.ApplicationArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSnsDestination(tfMap map[string]interface{}) *sesv2.SnsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.SnsDestination{}
+
+	if v, ok := tfMap["topic_arn"].(string); ok && v != "" {
+		apiObject.TopicArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEventDestination(apiObject *sesv2.EventDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":              aws.BoolValue(apiObject.Enabled),
+		"matching_event_types": aws.StringValueSlice(apiObject.MatchingEventTypes),
+	}
+
+	if v := apiObject.CloudWatchDestination; v != nil {
+		tfMap["cloud_watch_destination"] = []interface{}{flattenCloudWatchDestination(v)}
+	}
+
+	if v := apiObject.KinesisFirehoseDestination; v != nil {
+		tfMap["kinesis_firehose_destination"] = []interface{}{flattenKinesisFirehoseDestination(v)}
+	}
+
+	if v := apiObject.PinpointDestination; v != nil {
+		tfMap["pinpoint_destination"] = []interface{}{flattenPinpointDestination(v)}
+	}
+
+	if v := apiObject.SnsDestination; v != nil {
+		tfMap["sns_destination"] = []interface{}{flattenSnsDestination(v)}
+	}
+
+	return tfMap
+}
+
+func flattenCloudWatchDestination(apiObject *sesv2.CloudWatchDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DimensionConfigurations; v != nil {
+		tfMap["dimension_configuration"] = flattenCloudWatchDimensionConfigurations(v)
+	}
+
+	return tfMap
+}
+
+func flattenCloudWatchDimensionConfigurations(apiObjects []*sesv2.CloudWatchDimensionConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_dimension_value": aws.StringValue(apiObject.DefaultDimensionValue),
+			"dimension_name":          aws.StringValue(apiObject.DimensionName),
+			"dimension_value_source":  aws.StringValue(apiObject.DimensionValueSource),
+		})
+	}
+
+	return tfList
+}
+
+func flattenKinesisFirehoseDestination(apiObject *sesv2.KinesisFirehoseDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DeliveryStreamArn; v != nil {
+		tfMap["delivery_stream_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IamRoleArn; v != nil {
+		tfMap["iam_role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenPinpointDestination(apiObject *sesv2.PinpointDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ApplicationArn; v != nil {
+		tfMap["application_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSnsDestination(apiObject *sesv2.SnsDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TopicArn; v != nil {
+		tfMap["topic_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,396 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailIdentityCreate,
+		Read:   resourceEmailIdentityRead,
+		Update: resourceEmailIdentityUpdate,
+		Delete: resourceEmailIdentityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dkim_signing_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_signing_key_length": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_signing_private_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_selector"},
+						},
+						"domain_signing_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_private_key"},
+						},
+						"last_key_generation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_signing_key_length": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ValidateFunc:  validation.StringInSlice(sesv2.DkimSigningKeyLength_Values(), false),
+							ConflictsWith: []string{"dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector"},
+						},
+						"signing_attributes_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tokens": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mail_from_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"behavior_on_mx_failure": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sesv2.BehaviorOnMxFailureUseDefaultValue,
+							ValidateFunc: validation.StringInSlice(sesv2.BehaviorOnMxFailure_Values(), false),
+						},
+						"mail_from_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"mail_from_domain_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_for_sending_status": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEmailIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.CreateEmailIdentityInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration_set_name"); ok {
+		input.ConfigurationSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DkimSigningAttributes = expandDkimSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Email Identity: %s", input)
+	_, err := conn.CreateEmailIdentity(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Email Identity (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("mail_from_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putEmailIdentityMailFromAttributes(conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceEmailIdentityRead(d, meta)
+}
+
+func resourceEmailIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEmailIdentityByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("identity/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+	if output.DkimAttributes != nil {
+		tfMap := flattenDkimAttributes(output.DkimAttributes)
+
+		// The private key and selector of an externally generated key pair are never returned.
+		tfMap["domain_signing_private_key"] = d.Get("dkim_signing_attributes.0.domain_signing_private_key").(string)
+		tfMap["domain_signing_selector"] = d.Get("dkim_signing_attributes.0.domain_signing_selector").(string)
+
+		if err := d.Set("dkim_signing_attributes", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting dkim_signing_attributes: %w", err)
+		}
+	} else {
+		d.Set("dkim_signing_attributes", nil)
+	}
+	d.Set("email_identity", d.Id())
+	d.Set("identity_type", output.IdentityType)
+	if output.MailFromAttributes != nil && aws.StringValue(output.MailFromAttributes.MailFromDomain) != "" {
+		if err := d.Set("mail_from_attributes", []interface{}{flattenMailFromAttributes(output.MailFromAttributes)}); err != nil {
+			return fmt.Errorf("error setting mail_from_attributes: %w", err)
+		}
+	} else {
+		d.Set("mail_from_attributes", nil)
+	}
+	d.Set("verified_for_sending_status", output.VerifiedForSendingStatus)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if d.HasChange("configuration_set_name") {
+		input := &sesv2.PutEmailIdentityConfigurationSetAttributesInput{
+			EmailIdentity: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("configuration_set_name"); ok {
+			input.ConfigurationSetName = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Email Identity configuration set: %s", input)
+		if _, err := conn.PutEmailIdentityConfigurationSetAttributes(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) configuration set: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("dkim_signing_attributes") {
+		input := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
+			EmailIdentity:           aws.String(d.Id()),
+			SigningAttributesOrigin: aws.String(sesv2.DkimSigningAttributesOriginAwsSes),
+		}
+
+		if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SigningAttributes = expandDkimSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+
+			if input.SigningAttributes.DomainSigningPrivateKey != nil {
+				input.SigningAttributesOrigin = aws.String(sesv2.DkimSigningAttributesOriginExternal)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Email Identity DKIM signing attributes: %s", d.Id())
+		if _, err := conn.PutEmailIdentityDkimSigningAttributes(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) DKIM signing attributes: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("mail_from_attributes") {
+		var tfMap map[string]interface{}
+
+		if v, ok := d.GetOk("mail_from_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap = v.([]interface{})[0].(map[string]interface{})
+		}
+
+		if err := putEmailIdentityMailFromAttributes(conn, d.Id(), tfMap); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEmailIdentityRead(d, meta)
+}
+
+func resourceEmailIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Email Identity: %s", d.Id())
+	_, err := conn.DeleteEmailIdentity(&sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Email Identity (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// putEmailIdentityMailFromAttributes sets the identity's custom MAIL FROM domain.
+// A nil configuration disables the custom MAIL FROM domain.
+func putEmailIdentityMailFromAttributes(conn *sesv2.SESV2, name string, tfMap map[string]interface{}) error {
+	input := &sesv2.PutEmailIdentityMailFromAttributesInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	if v, ok := tfMap["behavior_on_mx_failure"].(string); ok && v != "" {
+		input.BehaviorOnMxFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["mail_from_domain"].(string); ok && v != "" {
+		input.MailFromDomain = aws.String(v)
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Email Identity MAIL FROM attributes: %s", input)
+	_, err := conn.PutEmailIdentityMailFromAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SESv2 Email Identity (%s) MAIL FROM attributes: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandDkimSigningAttributes(tfMap map[string]interface{}) *sesv2.DkimSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DkimSigningAttributes{}
+
+	if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
+		apiObject.DomainSigningPrivateKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["domain_signing_selector"].(string); ok && v != "" {
+		apiObject.DomainSigningSelector = aws.String(v)
+	}
+
+	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" && apiObject.DomainSigningPrivateKey == nil {
+		apiObject.NextSigningKeyLength = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDkimAttributes(apiObject *sesv2.DkimAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"current_signing_key_length": aws.StringValue(apiObject.CurrentSigningKeyLength),
+		"next_signing_key_length":    aws.StringValue(apiObject.NextSigningKeyLength),
+		"signing_attributes_origin":  aws.StringValue(apiObject.SigningAttributesOrigin),
+		"status":                     aws.StringValue(apiObject.Status),
+		"tokens":                     aws.StringValueSlice(apiObject.Tokens),
+	}
+
+	if v := apiObject.LastKeyGenerationTimestamp; v != nil {
+		tfMap["last_key_generation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenMailFromAttributes(apiObject *sesv2.MailFromAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"behavior_on_mx_failure":  aws.StringValue(apiObject.BehaviorOnMxFailure),
+		"mail_from_domain":        aws.StringValue(apiObject.MailFromDomain),
+		"mail_from_domain_status": aws.StringValue(apiObject.MailFromDomainStatus),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,418 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2EmailIdentity_basic(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig(emailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ses", regexp.MustCompile(`identity/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", emailAddress),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", sesv2.IdentityTypeEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "verified_for_sending_status", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_domain(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", domain),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", sesv2.IdentityTypeDomain),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", sesv2.DkimSigningAttributesOriginAwsSes),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_disappears(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig(emailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceEmailIdentity(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_tags(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfigTags1(emailAddress, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityConfigTags2(emailAddress, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEmailIdentityConfigTags1(emailAddress, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_configurationSetName(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	configurationSet1ResourceName := "aws_sesv2_configuration_set.test1"
+	configurationSet2ResourceName := "aws_sesv2_configuration_set.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfigConfigurationSetName(rName, emailAddress, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", configurationSet1ResourceName, "configuration_set_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityConfigConfigurationSetName(rName, emailAddress, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", configurationSet2ResourceName, "configuration_set_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_mailFromAttributes(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	domain := acctest.RandomDomainName()
+	mailFromDomain := fmt.Sprintf("bounce.%s", domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfigMailFromAttributes(domain, mailFromDomain, sesv2.BehaviorOnMxFailureUseDefaultValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.0.behavior_on_mx_failure", sesv2.BehaviorOnMxFailureUseDefaultValue),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.0.mail_from_domain", mailFromDomain),
+					resource.TestCheckResourceAttrSet(resourceName, "mail_from_attributes.0.mail_from_domain_status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityConfigMailFromAttributes(domain, mailFromDomain, sesv2.BehaviorOnMxFailureRejectMessage),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.0.behavior_on_mx_failure", sesv2.BehaviorOnMxFailureRejectMessage),
+				),
+			},
+			{
+				Config: testAccEmailIdentityConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_attributes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_dkimSigningAttributes(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	domain := acctest.RandomDomainName()
+	key := testAccDKIMPrivateKey(acctest.TLSRSAPrivateKeyPEM(2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfigNextSigningKeyLength(domain, sesv2.DkimSigningKeyLengthRsa1024Bit),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.next_signing_key_length", sesv2.DkimSigningKeyLengthRsa1024Bit),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", sesv2.DkimSigningAttributesOriginAwsSes),
+				),
+			},
+			{
+				Config: testAccEmailIdentityConfigNextSigningKeyLength(domain, sesv2.DkimSigningKeyLengthRsa2048Bit),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.next_signing_key_length", sesv2.DkimSigningKeyLengthRsa2048Bit),
+				),
+			},
+			{
+				Config: testAccEmailIdentityConfigDomainSigning(domain, key, "selector"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_selector", "selector"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", sesv2.DkimSigningAttributesOriginExternal),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector"},
+			},
+		},
+	})
+}
+
+// testAccDKIMPrivateKey returns the base64-encoded key without the PEM encapsulation boundaries, as SES expects.
+func testAccDKIMPrivateKey(keyPEM string) string {
+	var lines []string
+
+	for _, line := range strings.Split(keyPEM, "\n") {
+		if line == "" || strings.HasPrefix(line, "-----") {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "")
+}
+
+func testAccCheckEmailIdentityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		_, err := tfsesv2.FindEmailIdentityByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEmailIdentityDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_email_identity" {
+			continue
+		}
+
+		_, err := tfsesv2.FindEmailIdentityByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Email Identity %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEmailIdentityConfig(emailIdentity string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+`, emailIdentity)
+}
+
+func testAccEmailIdentityConfigConfigurationSetName(rName, emailIdentity, configurationSet string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test1" {
+  configuration_set_name = "%[1]s-1"
+}
+
+resource "aws_sesv2_configuration_set" "test2" {
+  configuration_set_name = "%[1]s-2"
+}
+
+resource "aws_sesv2_email_identity" "test" {
+  email_identity         = %[2]q
+  configuration_set_name = aws_sesv2_configuration_set.%[3]s.configuration_set_name
+}
+`, rName, emailIdentity, configurationSet)
+}
+
+func testAccEmailIdentityConfigMailFromAttributes(domain, mailFromDomain, behaviorOnMxFailure string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  mail_from_attributes {
+    behavior_on_mx_failure = %[3]q
+    mail_from_domain       = %[2]q
+  }
+}
+`, domain, mailFromDomain, behaviorOnMxFailure)
+}
+
+func testAccEmailIdentityConfigNextSigningKeyLength(domain, nextSigningKeyLength string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  dkim_signing_attributes {
+    next_signing_key_length = %[2]q
+  }
+}
+`, domain, nextSigningKeyLength)
+}
+
+func testAccEmailIdentityConfigDomainSigning(domain, key, selector string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  dkim_signing_attributes {
+    domain_signing_private_key = %[2]q
+    domain_signing_selector    = %[3]q
+  }
+}
+`, domain, key, selector)
+}
+
+func testAccEmailIdentityConfigTags1(emailIdentity, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, emailIdentity, tagKey1, tagValue1)
+}
+
+func testAccEmailIdentityConfigTags2(emailIdentity, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, emailIdentity, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,152 @@
+package sesv2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConfigurationSetByName(conn *sesv2.SESV2, name string) (*sesv2.GetConfigurationSetOutput, error) {
+	input := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	output, err := conn.GetConfigurationSet(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindConfigurationSetEventDestinationByTwoPartKey(conn *sesv2.SESV2, configurationSetName, eventDestinationName string) (*sesv2.EventDestination, error) {
+	input := &sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+	}
+
+	output, err := conn.GetConfigurationSetEventDestinations(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.EventDestinations {
+		if aws.StringValue(v.Name) == eventDestinationName {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindContactListByName(conn *sesv2.SESV2, name string) (*sesv2.GetContactListOutput, error) {
+	input := &sesv2.GetContactListInput{
+		ContactListName: aws.String(name),
+	}
+
+	output, err := conn.GetContactList(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindDedicatedIPPoolByName returns the name of the dedicated IP pool if it exists.
+// There is no API to describe a single pool so the account's pools are listed.
+func FindDedicatedIPPoolByName(conn *sesv2.SESV2, name string) (string, error) {
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+	var found bool
+
+	err := conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DedicatedIpPools {
+			if aws.StringValue(v) == name {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return "", &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return name, nil
+}
+
+func FindEmailIdentityByName(conn *sesv2.SESV2, name string) (*sesv2.GetEmailIdentityOutput, error) {
+	input := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	output, err := conn.GetEmailIdentity(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}